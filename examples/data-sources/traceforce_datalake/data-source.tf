@@ -0,0 +1,14 @@
+# Look up a datalake by ID
+data "traceforce_datalake" "by_id" {
+  id = "00000000-0000-0000-0000-000000000000"
+}
+
+# Look up a datalake by name within a project owned by another workspace
+data "traceforce_datalake" "warehouse" {
+  project_id = data.traceforce_project.analytics.id
+  name       = "production-warehouse"
+}
+
+output "warehouse_region" {
+  value = data.traceforce_datalake.warehouse.region
+}
@@ -0,0 +1,20 @@
+# Get all datalakes of a project
+data "traceforce_datalakes" "analytics" {
+  project_id = data.traceforce_project.analytics.id
+}
+
+# Find every failed datalake across the organization
+data "traceforce_datalakes" "failed" {
+  filter {
+    status = "failed"
+  }
+}
+
+# Filter by cloud provider, name and creation time
+data "traceforce_datalakes" "recent_gcp_production" {
+  filter {
+    cloud_provider = "gcp"
+    name_regex     = "^prod-"
+    created_after  = "2025-01-01T00:00:00Z"
+  }
+}
@@ -0,0 +1,22 @@
+# Look up a project by ID
+data "traceforce_project" "by_id" {
+  id = "00000000-0000-0000-0000-000000000000"
+}
+
+# Look up a project by name
+data "traceforce_project" "analytics" {
+  name = "analytics"
+}
+
+# Look up a project by the native ID of its cloud account
+data "traceforce_project" "aws_account" {
+  cloud_provider = "aws"
+  native_id      = "123456789012"
+}
+
+# Use project data in other resources
+resource "traceforce_datalake" "from_existing_project" {
+  name       = "new-analytics"
+  project_id = data.traceforce_project.analytics.id
+  type       = "bigquery"
+}
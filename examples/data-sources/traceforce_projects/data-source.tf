@@ -3,15 +3,15 @@ data "traceforce_projects" "all" {}
 
 # Filter projects by status
 data "traceforce_projects" "connected" {
-  filter = {
-    status = "Connected"
+  filter {
+    status = "connected"
   }
 }
 
 # Filter by cloud provider
 data "traceforce_projects" "aws_projects" {
-  filter = {
-    cloud_provider = "AWS"
+  filter {
+    cloud_provider = "aws"
   }
 }
 
@@ -19,5 +19,5 @@ data "traceforce_projects" "aws_projects" {
 resource "traceforce_datalake" "from_existing_project" {
   name       = "new-analytics"
   project_id = data.traceforce_projects.all.projects[0].id
-  type       = "BigQuery"
+  type       = "bigquery"
 }
\ No newline at end of file
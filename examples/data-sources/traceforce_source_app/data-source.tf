@@ -0,0 +1,16 @@
+# Look up a source app by ID
+data "traceforce_source_app" "by_id" {
+  id = "00000000-0000-0000-0000-000000000000"
+}
+
+# Look up a source app by name within a hosting environment owned by another workspace
+data "traceforce_source_app" "crm" {
+  hosting_environment_id = data.traceforce_project.analytics.id
+  name                   = "salesforce-production"
+}
+
+# Link the source app to a datalake managed in this workspace
+resource "traceforce_source_app_datalake_link" "crm_to_warehouse" {
+  source_app_id = data.traceforce_source_app.crm.id
+  datalake_id   = traceforce_datalake.warehouse.id
+}
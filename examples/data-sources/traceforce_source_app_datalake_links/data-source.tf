@@ -0,0 +1,13 @@
+# Find every source app that feeds a datalake
+data "traceforce_source_app_datalake_links" "warehouse" {
+  datalake_id = data.traceforce_datalake.warehouse.id
+}
+
+output "warehouse_source_app_ids" {
+  value = data.traceforce_source_app_datalake_links.warehouse.links[*].source_app_id
+}
+
+# Get all links of a hosting environment
+data "traceforce_source_app_datalake_links" "analytics" {
+  hosting_environment_id = data.traceforce_project.analytics.id
+}
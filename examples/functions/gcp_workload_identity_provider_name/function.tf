@@ -0,0 +1,14 @@
+resource "traceforce_post_connection" "example" {
+  # ...
+
+  infrastructure = {
+    base = {
+      workload_identity_provider_name = provider::traceforce::gcp_workload_identity_provider_name(
+        data.google_project.current.number,
+        google_iam_workload_identity_pool.traceforce.workload_identity_pool_id,
+        google_iam_workload_identity_pool_provider.control_plane.workload_identity_pool_provider_id,
+      )
+      # ...
+    }
+  }
+}
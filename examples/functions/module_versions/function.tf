@@ -0,0 +1,9 @@
+resource "traceforce_post_connection" "example" {
+  # ...
+
+  terraform_module_versions = provider::traceforce::module_versions(
+    "v1.4.2",
+    { bigquery = "1.0.0" },
+    { salesforce = "1.2.0" },
+  )
+}
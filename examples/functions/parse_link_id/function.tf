@@ -0,0 +1,7 @@
+locals {
+  link = provider::traceforce::parse_link_id("00000000-0000-0000-0000-000000000001/00000000-0000-0000-0000-000000000002")
+}
+
+output "linked_datalake_id" {
+  value = local.link.datalake_id
+}
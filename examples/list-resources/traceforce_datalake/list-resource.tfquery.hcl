@@ -0,0 +1,7 @@
+list "traceforce_datalake" "production" {
+  provider = traceforce
+
+  config {
+    project_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
@@ -0,0 +1,11 @@
+list "traceforce_project" "all" {
+  provider = traceforce
+}
+
+list "traceforce_project" "gcp" {
+  provider = traceforce
+
+  config {
+    cloud_provider = "gcp"
+  }
+}
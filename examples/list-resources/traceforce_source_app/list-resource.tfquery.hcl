@@ -0,0 +1,7 @@
+list "traceforce_source_app" "production" {
+  provider = traceforce
+
+  config {
+    hosting_environment_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
@@ -0,0 +1,7 @@
+list "traceforce_source_app_datalake_link" "salesforce" {
+  provider = traceforce
+
+  config {
+    source_app_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
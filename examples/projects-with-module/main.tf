@@ -15,8 +15,8 @@ provider "traceforce" {}
 # Create a project in GCP
 resource "traceforce_project" "example-gcp" {
   name           = "example-gcp"
-  type           = "Customer Managed"
-  cloud_provider = "GCP"
+  type           = "customer_managed"
+  cloud_provider = "gcp"
   native_id      = var.google_project_id
 }
 
@@ -32,7 +32,7 @@ module "google-bigquery-datasets" {
 resource "traceforce_datalake" "bigquery-analytics" {
   name       = "bigquery-analytics"
   project_id = traceforce_project.example-gcp.id
-  type       = "BigQuery"
+  type       = "bigquery"
   depends_on = [module.google-bigquery-datasets]
 }
 
@@ -11,8 +11,8 @@ provider "traceforce" {}
 # Create a project in AWS
 resource "traceforce_project" "example-aws" {
   name           = "example-project"
-  type           = "Customer Managed"
-  cloud_provider = "AWS"
+  type           = "customer_managed"
+  cloud_provider = "aws"
   native_id      = "123456789012"
 }
 
@@ -20,14 +20,14 @@ resource "traceforce_project" "example-aws" {
 resource "traceforce_datalake" "analytics" {
   name       = "analytics"
   project_id = traceforce_project.example-aws.id
-  type       = "BigQuery"
+  type       = "bigquery"
 }
 
 # Create a source app connected to the datalake
 resource "traceforce_source_app" "salesforce" {
   name        = "salesforce-prod"
   datalake_id = traceforce_datalake.analytics.id
-  type        = "Salesforce"
+  type        = "salesforce"
 }
 
 # Establish post-connection setup
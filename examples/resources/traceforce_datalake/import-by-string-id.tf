@@ -0,0 +1,4 @@
+import {
+  to = traceforce_datalake.example
+  id = "${traceforce_project.production.id}/analytics"
+}
@@ -0,0 +1,5 @@
+# A datalake can be imported by its ID.
+terraform import traceforce_datalake.example 00000000-0000-0000-0000-000000000000
+
+# A datalake can also be imported by the ID of its project and its name.
+terraform import traceforce_datalake.example <project_id>/analytics
@@ -0,0 +1,6 @@
+import {
+  to = traceforce_post_connection.example
+  identity = {
+    traceforce_hosting_environment_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
@@ -0,0 +1,3 @@
+# A post-connection is imported by the ID of its hosting environment. The API does
+# not expose the applied configuration, so it is sent again by the next apply.
+terraform import traceforce_post_connection.example 00000000-0000-0000-0000-000000000000
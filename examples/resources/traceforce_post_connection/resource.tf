@@ -22,29 +22,27 @@ resource "traceforce_post_connection" "example" {
     }
   }
 
-  terraform_url             = "https://github.com/traceforce/terraform-modules"
-  terraform_module_versions = <<-EOT
-  {
-    "base_infrastructure": {
-      "major": 1,
-      "minor": 0
-    },
-    "datalake_connectors": {
-      "bigquery": {
-        "major": 1,
-        "minor": 0
+  terraform_url = "https://github.com/traceforce/terraform-modules"
+  terraform_module_versions = {
+    base_infrastructure = {
+      major = 1
+      minor = 0
+    }
+    datalake_connectors = {
+      bigquery = {
+        major = 1
+        minor = 0
       }
-    },
-    "source_connectors": {
-      "salesforce": {
-        "major": 1,
-        "minor": 0
+    }
+    source_connectors = {
+      salesforce = {
+        major = 1
+        minor = 0
       }
     }
   }
-  EOT
-  deployed_datalake_ids     = ["datalake-abc123"]
-  deployed_source_app_ids   = ["sourceapp-def456"]
+  deployed_datalake_ids   = ["datalake-abc123"]
+  deployed_source_app_ids = ["sourceapp-def456"]
 
   depends_on = [traceforce_hosting_environment.example]
 }
\ No newline at end of file
@@ -0,0 +1,4 @@
+import {
+  to = traceforce_project.example
+  id = "name:production"
+}
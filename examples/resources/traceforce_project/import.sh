@@ -0,0 +1,8 @@
+# A project can be imported by its ID.
+terraform import traceforce_project.example 00000000-0000-0000-0000-000000000000
+
+# A project can also be imported by its name.
+terraform import traceforce_project.example name:production
+
+# Or by its cloud provider and native ID.
+terraform import traceforce_project.example gcp:my-gcp-project-id
@@ -1,14 +1,14 @@
 resource "traceforce_project" "production" {
   name           = "production"
   type           = "customer_managed"
-  cloud_provider = "AWS"
+  cloud_provider = "aws"
   native_id      = "123456789012"
 }
 
 resource "traceforce_project" "staging" {
   name           = "staging"
   type           = "traceforce_managed"
-  cloud_provider = "GCP"
+  cloud_provider = "gcp"
   native_id      = "my-gcp-project-id"
 }
 
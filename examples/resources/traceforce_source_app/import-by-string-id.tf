@@ -0,0 +1,4 @@
+import {
+  to = traceforce_source_app.example
+  id = "${traceforce_project.production.id}/salesforce-prod"
+}
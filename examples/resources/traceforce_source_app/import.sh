@@ -0,0 +1,5 @@
+# A source app can be imported by its ID.
+terraform import traceforce_source_app.example 00000000-0000-0000-0000-000000000000
+
+# A source app can also be imported by the ID of its project and its name.
+terraform import traceforce_source_app.example <project_id>/salesforce-prod
@@ -0,0 +1,7 @@
+import {
+  to = traceforce_source_app_datalake_link.example
+  identity = {
+    source_app_id = traceforce_source_app.salesforce.id
+    datalake_id   = traceforce_datalake.analytics.id
+  }
+}
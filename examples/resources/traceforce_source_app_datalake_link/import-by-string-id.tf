@@ -0,0 +1,4 @@
+import {
+  to = traceforce_source_app_datalake_link.example
+  id = "${traceforce_source_app.salesforce.id}/${traceforce_datalake.analytics.id}"
+}
@@ -0,0 +1,5 @@
+# A link can be imported by its ID.
+terraform import traceforce_source_app_datalake_link.example 00000000-0000-0000-0000-000000000000
+
+# A link can also be imported by the IDs of the source app and datalake it links.
+terraform import traceforce_source_app_datalake_link.example <source_app_id>/<datalake_id>
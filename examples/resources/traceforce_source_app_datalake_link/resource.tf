@@ -1,7 +1,7 @@
 resource "traceforce_project" "production" {
   name           = "production"
   type           = "customer_managed"
-  cloud_provider = "GCP"
+  cloud_provider = "gcp"
   native_id      = "my-gcp-project-id"
 }
 
@@ -0,0 +1,299 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+const (
+	// defaultEndpoint is the Traceforce API used when no endpoint is configured.
+	defaultEndpoint = "https://api.traceforce.co/api/v1"
+
+	// defaultRequestTimeout bounds a single HTTP attempt against the API.
+	defaultRequestTimeout = 30 * time.Second
+)
+
+// apiClient is the Traceforce API client shared by all resources and data
+// sources. It makes every call through the traceforce-go-sdk client, adding
+// context cancellation, retries according to the provider configuration,
+// logging and typed API errors.
+type apiClient struct {
+	sdk          *traceforce.Client
+	baseURL      string
+	apiKey       string
+	extraHeaders map[string]string
+	retry        retryPolicy
+}
+
+// apiModuleVersions is the JSON form of the Terraform module versions sent
+// with a post-connection. Connectors are keyed by datalake or source app type.
+type apiModuleVersions struct {
+	BaseInfrastructure *apiModuleVersion           `json:"base_infrastructure,omitempty"`
+	DatalakeConnectors map[string]apiModuleVersion `json:"datalake_connectors,omitempty"`
+	SourceConnectors   map[string]apiModuleVersion `json:"source_connectors,omitempty"`
+}
+
+// apiModuleVersion is the version of a single Terraform module.
+type apiModuleVersion struct {
+	Major int64 `json:"major"`
+	Minor int64 `json:"minor"`
+}
+
+// apiClientOptions holds the optional settings of an apiClient.
+type apiClientOptions struct {
+	// ExtraHeaders are added to every API request.
+	ExtraHeaders map[string]string
+
+	// Retry controls how failed requests are retried.
+	Retry retryPolicy
+}
+
+// newAPIClient creates a new Traceforce API client. An empty endpoint selects
+// the default Traceforce API.
+func newAPIClient(apiKey, endpoint string, options apiClientOptions) (*apiClient, error) {
+	if endpoint == "" {
+		endpoint = defaultEndpoint
+	}
+
+	extraHeaders := make(map[string]string, len(options.ExtraHeaders))
+	for k, v := range options.ExtraHeaders {
+		extraHeaders[k] = v
+	}
+
+	// The SDK client has no timeout of its own: every attempt is bounded by
+	// its context instead. See attempt.
+	sdk, err := traceforce.NewClient(apiKey, endpoint, &traceforce.ClientOptions{
+		ExtraHeaders: extraHeaders,
+		HTTPClient:   &http.Client{Transport: &sdkTransport{base: http.DefaultTransport}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Traceforce SDK client: %w", err)
+	}
+
+	return &apiClient{
+		sdk:          sdk,
+		baseURL:      endpoint,
+		apiKey:       apiKey,
+		extraHeaders: extraHeaders,
+		retry:        options.Retry,
+	}, nil
+}
+
+// call makes an API call with the given HTTP method through fn and returns its
+// result. See do.
+func call[T any](ctx context.Context, c *apiClient, method string, fn func(sdk *traceforce.Client) (T, error)) (T, error) {
+	var result T
+	err := c.do(ctx, method, func(sdk *traceforce.Client) error {
+		var err error
+		result, err = fn(sdk)
+		return err
+	})
+	return result, err
+}
+
+// do makes an API call with the given HTTP method through fn, retrying it when
+// allowed. Error responses are returned as *apiError. The request, and any
+// wait before retrying it, is aborted as soon as ctx is done.
+func (c *apiClient) do(ctx context.Context, method string, fn func(sdk *traceforce.Client) error) error {
+	ctx = c.logContext(ctx)
+
+	for number := 0; ; number++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		attempt, err := c.attempt(ctx, number, fn)
+		if err == nil {
+			return nil
+		}
+
+		// Calls the SDK rejected before sending a request, such as for an
+		// invalid ID, are not retried. Once the API answered, only the
+		// response decides whether the request is retried.
+		if !attempt.sent || number >= c.retry.MaxRetries {
+			return err
+		}
+		transportErr := err
+		if attempt.resp != nil {
+			transportErr = nil
+		}
+		if !shouldRetry(method, attempt.resp, transportErr) {
+			return err
+		}
+
+		timer := time.NewTimer(c.retry.backoff(number, attempt.resp))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// attempt makes a single attempt, numbered from zero, at an API call through
+// fn. It passes fn an SDK client whose requests carry the attempt in their
+// context, for sdkTransport to log them and record the response.
+func (c *apiClient) attempt(ctx context.Context, number int, fn func(sdk *traceforce.Client) error) (*apiAttempt, error) {
+	attempt := &apiAttempt{number: number}
+	ctx, cancel := context.WithTimeout(withAttempt(ctx, attempt), defaultRequestTimeout)
+	defer cancel()
+
+	if err := fn(c.sdk.WithContext(ctx)); err != nil {
+		return attempt, attempt.err(err)
+	}
+	return attempt, nil
+}
+
+func (c *apiClient) CreateHostingEnvironment(ctx context.Context, req traceforce.CreateHostingEnvironmentRequest) (*traceforce.HostingEnvironment, error) {
+	return call(ctx, c, http.MethodPost, func(sdk *traceforce.Client) (*traceforce.HostingEnvironment, error) {
+		return sdk.CreateHostingEnvironment(req)
+	})
+}
+
+func (c *apiClient) GetHostingEnvironments(ctx context.Context) ([]traceforce.HostingEnvironment, error) {
+	return call(ctx, c, http.MethodGet, func(sdk *traceforce.Client) ([]traceforce.HostingEnvironment, error) {
+		return sdk.GetHostingEnvironments()
+	})
+}
+
+func (c *apiClient) GetHostingEnvironment(ctx context.Context, id string) (*traceforce.HostingEnvironment, error) {
+	return call(ctx, c, http.MethodGet, func(sdk *traceforce.Client) (*traceforce.HostingEnvironment, error) {
+		return sdk.GetHostingEnvironment(id)
+	})
+}
+
+func (c *apiClient) UpdateHostingEnvironment(ctx context.Context, id string, req traceforce.UpdateHostingEnvironmentRequest) (*traceforce.HostingEnvironment, error) {
+	return call(ctx, c, http.MethodPatch, func(sdk *traceforce.Client) (*traceforce.HostingEnvironment, error) {
+		return sdk.UpdateHostingEnvironment(id, req)
+	})
+}
+
+func (c *apiClient) DeleteHostingEnvironment(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, func(sdk *traceforce.Client) error {
+		return sdk.DeleteHostingEnvironment(id)
+	})
+}
+
+// PostConnection applies the post-connection configuration to the hosting
+// environment. The API does not return the applied configuration.
+func (c *apiClient) PostConnection(ctx context.Context, id string, req *traceforce.PostConnectionRequest) error {
+	return c.do(ctx, http.MethodPost, func(sdk *traceforce.Client) error {
+		return sdk.PostConnection(id, req)
+	})
+}
+
+func (c *apiClient) CreateDatalake(ctx context.Context, req traceforce.CreateDatalakeRequest) (*traceforce.Datalake, error) {
+	return call(ctx, c, http.MethodPost, func(sdk *traceforce.Client) (*traceforce.Datalake, error) {
+		return sdk.CreateDatalake(req)
+	})
+}
+
+func (c *apiClient) GetDatalakes(ctx context.Context) ([]traceforce.Datalake, error) {
+	return call(ctx, c, http.MethodGet, func(sdk *traceforce.Client) ([]traceforce.Datalake, error) {
+		return sdk.GetDatalakes()
+	})
+}
+
+func (c *apiClient) GetDatalakesByHostingEnvironment(ctx context.Context, hostingEnvironmentID string) ([]traceforce.Datalake, error) {
+	return call(ctx, c, http.MethodGet, func(sdk *traceforce.Client) ([]traceforce.Datalake, error) {
+		return sdk.GetDatalakesByHostingEnvironment(hostingEnvironmentID)
+	})
+}
+
+func (c *apiClient) GetDatalake(ctx context.Context, id string) (*traceforce.Datalake, error) {
+	return call(ctx, c, http.MethodGet, func(sdk *traceforce.Client) (*traceforce.Datalake, error) {
+		return sdk.GetDatalake(id)
+	})
+}
+
+func (c *apiClient) UpdateDatalake(ctx context.Context, id string, req traceforce.UpdateDatalakeRequest) (*traceforce.Datalake, error) {
+	return call(ctx, c, http.MethodPatch, func(sdk *traceforce.Client) (*traceforce.Datalake, error) {
+		return sdk.UpdateDatalake(id, req)
+	})
+}
+
+func (c *apiClient) DeleteDatalake(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, func(sdk *traceforce.Client) error {
+		return sdk.DeleteDatalake(id)
+	})
+}
+
+func (c *apiClient) CreateSourceApp(ctx context.Context, req traceforce.CreateSourceAppRequest) (*traceforce.SourceApp, error) {
+	return call(ctx, c, http.MethodPost, func(sdk *traceforce.Client) (*traceforce.SourceApp, error) {
+		return sdk.CreateSourceApp(req)
+	})
+}
+
+func (c *apiClient) GetSourceApps(ctx context.Context) ([]traceforce.SourceApp, error) {
+	return call(ctx, c, http.MethodGet, func(sdk *traceforce.Client) ([]traceforce.SourceApp, error) {
+		return sdk.GetSourceApps()
+	})
+}
+
+func (c *apiClient) GetSourceAppsByHostingEnvironment(ctx context.Context, hostingEnvironmentID string) ([]traceforce.SourceApp, error) {
+	return call(ctx, c, http.MethodGet, func(sdk *traceforce.Client) ([]traceforce.SourceApp, error) {
+		return sdk.GetSourceAppsByHostingEnvironment(hostingEnvironmentID)
+	})
+}
+
+func (c *apiClient) GetSourceApp(ctx context.Context, id string) (*traceforce.SourceApp, error) {
+	return call(ctx, c, http.MethodGet, func(sdk *traceforce.Client) (*traceforce.SourceApp, error) {
+		return sdk.GetSourceApp(id)
+	})
+}
+
+func (c *apiClient) UpdateSourceApp(ctx context.Context, id string, req traceforce.UpdateSourceAppRequest) (*traceforce.SourceApp, error) {
+	return call(ctx, c, http.MethodPatch, func(sdk *traceforce.Client) (*traceforce.SourceApp, error) {
+		return sdk.UpdateSourceApp(id, req)
+	})
+}
+
+func (c *apiClient) DeleteSourceApp(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, func(sdk *traceforce.Client) error {
+		return sdk.DeleteSourceApp(id)
+	})
+}
+
+func (c *apiClient) CreateSourceAppDatalakeLink(ctx context.Context, req traceforce.CreateSourceAppDatalakeLinkRequest) (*traceforce.SourceAppDatalakeLink, error) {
+	return call(ctx, c, http.MethodPost, func(sdk *traceforce.Client) (*traceforce.SourceAppDatalakeLink, error) {
+		return sdk.CreateSourceAppDatalakeLink(req)
+	})
+}
+
+func (c *apiClient) GetSourceAppDatalakeLinks(ctx context.Context) ([]traceforce.SourceAppDatalakeLink, error) {
+	return call(ctx, c, http.MethodGet, func(sdk *traceforce.Client) ([]traceforce.SourceAppDatalakeLink, error) {
+		return sdk.GetSourceAppDatalakeLinks()
+	})
+}
+
+func (c *apiClient) GetSourceAppDatalakeLinksBySourceApp(ctx context.Context, sourceAppID string) ([]traceforce.SourceAppDatalakeLink, error) {
+	return call(ctx, c, http.MethodGet, func(sdk *traceforce.Client) ([]traceforce.SourceAppDatalakeLink, error) {
+		return sdk.GetSourceAppDatalakeLinksBySourceApp(sourceAppID)
+	})
+}
+
+func (c *apiClient) GetSourceAppDatalakeLinksByDatalake(ctx context.Context, datalakeID string) ([]traceforce.SourceAppDatalakeLink, error) {
+	return call(ctx, c, http.MethodGet, func(sdk *traceforce.Client) ([]traceforce.SourceAppDatalakeLink, error) {
+		return sdk.GetSourceAppDatalakeLinksByDatalake(datalakeID)
+	})
+}
+
+func (c *apiClient) GetSourceAppDatalakeLink(ctx context.Context, id string) (*traceforce.SourceAppDatalakeLink, error) {
+	return call(ctx, c, http.MethodGet, func(sdk *traceforce.Client) (*traceforce.SourceAppDatalakeLink, error) {
+		return sdk.GetSourceAppDatalakeLink(id)
+	})
+}
+
+func (c *apiClient) DeleteSourceAppDatalakeLink(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, func(sdk *traceforce.Client) error {
+		return sdk.DeleteSourceAppDatalakeLink(id)
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAPIClientContextCancellation(t *testing.T) {
+	testCases := map[string]struct {
+		handler          func(w http.ResponseWriter, r *http.Request)
+		context          func(ctx context.Context) (context.Context, context.CancelFunc)
+		expectedErr      error
+		expectedAttempts int32
+	}{
+		"cancelled-before-request": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`[]`))
+			},
+			context: func(ctx context.Context) (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(ctx)
+				cancel()
+				return ctx, cancel
+			},
+			expectedErr:      context.Canceled,
+			expectedAttempts: 0,
+		},
+		"cancelled-in-flight": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				<-r.Context().Done()
+			},
+			context: func(ctx context.Context) (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(ctx)
+				time.AfterFunc(50*time.Millisecond, cancel)
+				return ctx, cancel
+			},
+			expectedErr:      context.Canceled,
+			expectedAttempts: 1,
+		},
+		"deadline-during-retry-wait": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Retry-After", "60")
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			context: func(ctx context.Context) (context.Context, context.CancelFunc) {
+				return context.WithTimeout(ctx, 50*time.Millisecond)
+			},
+			expectedErr:      context.DeadlineExceeded,
+			expectedAttempts: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts.Add(1)
+				testCase.handler(w, r)
+			}))
+			defer server.Close()
+
+			client := mustAPIClient("test-key", server.URL, apiClientOptions{
+				Retry: defaultRetryPolicy(),
+			})
+
+			ctx, cancel := testCase.context(t.Context())
+			defer cancel()
+
+			start := time.Now()
+			_, err := client.GetHostingEnvironments(ctx)
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Errorf("expected %v, got: %v", testCase.expectedErr, err)
+			}
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Errorf("expected the request to be aborted promptly, took %s", elapsed)
+			}
+			if got := attempts.Load(); got != testCase.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", testCase.expectedAttempts, got)
+			}
+		})
+	}
+}
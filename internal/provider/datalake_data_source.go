@@ -0,0 +1,200 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &datalakeDataSource{}
+	_ datasource.DataSourceWithConfigure        = &datalakeDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &datalakeDataSource{}
+)
+
+func NewDatalakeDataSource() datasource.DataSource {
+	return &datalakeDataSource{}
+}
+
+// datalakeDataSource looks up a single datalake by ID, or by name within a
+// project.
+type datalakeDataSource struct {
+	client *apiClient
+}
+
+// datalakeDataSourceModel maps the data source schema data.
+type datalakeDataSourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	ProjectId           types.String `tfsdk:"project_id"`
+	Type                types.String `tfsdk:"type"`
+	Name                types.String `tfsdk:"name"`
+	Status              types.String `tfsdk:"status"`
+	EnvironmentNativeID types.String `tfsdk:"environment_native_id"`
+	Region              types.String `tfsdk:"region"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	UpdatedAt           types.String `tfsdk:"updated_at"`
+}
+
+func (d *datalakeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_datalake"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *datalakeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *datalakeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single datalake by id, or by name within a project.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "System generated ID of the datalake. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the datalake. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "ID of the project this datalake belongs to. Required when looking up by name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: fmt.Sprintf("Type of datalake. Valid values: %s.", traceforce.DatalakeTypeBigQuery),
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: fmt.Sprintf("Status of the datalake. Valid values: %s, %s, %s, %s.",
+					traceforce.DatalakeStatusPending,
+					traceforce.DatalakeStatusDeployed,
+					traceforce.DatalakeStatusReady,
+					traceforce.DatalakeStatusFailed),
+				Computed: true,
+			},
+			"environment_native_id": schema.StringAttribute{
+				Description: "Native ID of the environment where the datalake is deployed (e.g., GCP project ID).",
+				Computed:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Datalake region.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Date and time the datalake was created",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Date and time the datalake was last updated",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *datalakeDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("name"),
+			path.MatchRoot("project_id"),
+		),
+	}
+}
+
+func (d *datalakeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config datalakeDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var datalake traceforce.Datalake
+	if !config.ID.IsNull() {
+		found, err := d.client.GetDatalake(ctx, config.ID.ValueString())
+		if isNotFound(err) {
+			resp.Diagnostics.AddError("No datalake found", fmt.Sprintf("No datalake has id %q.", config.ID.ValueString()))
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic("Error reading datalake", err))
+			return
+		}
+		datalake = *found
+	} else {
+		datalakes, err := d.client.GetDatalakesByHostingEnvironment(ctx, config.ProjectId.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic("Error reading datalakes by hosting environment", err))
+			return
+		}
+
+		var matches []traceforce.Datalake
+		for _, candidate := range datalakes {
+			if candidate.Name == config.Name.ValueString() {
+				matches = append(matches, candidate)
+			}
+		}
+
+		description := fmt.Sprintf("name %q in project %q", config.Name.ValueString(), config.ProjectId.ValueString())
+		match, ok := lookupOne(matches, func(datalake traceforce.Datalake) string { return datalake.ID }, "datalake", description, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		datalake = match
+	}
+
+	// Set state
+	state := datalakeDataSourceModel{
+		ID:                  types.StringValue(datalake.ID),
+		ProjectId:           types.StringValue(datalake.HostingEnvironmentID),
+		Type:                types.StringValue(string(datalake.Type)),
+		Name:                types.StringValue(datalake.Name),
+		Status:              types.StringValue(string(datalake.Status)),
+		EnvironmentNativeID: types.StringValue(datalake.EnvironmentNativeID),
+		Region:              types.StringValue(datalake.Region),
+		CreatedAt:           types.StringValue(datalake.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:           types.StringValue(datalake.UpdatedAt.Format(time.RFC3339)),
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+func TestAccDatalakeDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "traceforce_datalakes" "all" {}
+
+data "traceforce_datalake" "by_id" {
+  id = data.traceforce_datalakes.all.datalakes[0].id
+}
+
+data "traceforce_datalake" "by_name" {
+  project_id = data.traceforce_datalakes.all.datalakes[0].project_id
+  name       = data.traceforce_datalakes.all.datalakes[0].name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.traceforce_datalake.by_id", "name", "data.traceforce_datalakes.all", "datalakes.0.name"),
+					resource.TestCheckResourceAttrSet("data.traceforce_datalake.by_id", "environment_native_id"),
+					resource.TestCheckResourceAttrSet("data.traceforce_datalake.by_id", "region"),
+					resource.TestCheckResourceAttrPair("data.traceforce_datalake.by_name", "id", "data.traceforce_datalake.by_id", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestDatalakeDataSourceRead(t *testing.T) {
+	f := newFakeAPI(t)
+	projectID := uuid.New().String()
+	datalakes := []traceforce.Datalake{
+		{ID: uuid.New().String(), HostingEnvironmentID: projectID, Name: "warehouse", Type: traceforce.DatalakeTypeBigQuery, EnvironmentNativeID: "analytics-prod", Region: "us-central1"},
+		{ID: uuid.New().String(), HostingEnvironmentID: projectID, Name: "shared", Type: traceforce.DatalakeTypeBigQuery},
+		{ID: uuid.New().String(), HostingEnvironmentID: projectID, Name: "shared", Type: traceforce.DatalakeTypeBigQuery},
+	}
+	for _, datalake := range datalakes {
+		f.add(datalake)
+	}
+
+	testLookup(t, f, NewDatalakeDataSource, map[string]lookupTestCase[datalakeDataSourceModel]{
+		"by-id": {
+			config:     datalakeDataSourceModel{ID: types.StringValue(datalakes[0].ID)},
+			expectedID: datalakes[0].ID,
+		},
+		"by-name": {
+			config:     datalakeDataSourceModel{ProjectId: types.StringValue(projectID), Name: types.StringValue("warehouse")},
+			expectedID: datalakes[0].ID,
+		},
+		"unknown-id": {
+			config:      datalakeDataSourceModel{ID: types.StringValue(missingID)},
+			expectError: "No datalake found",
+		},
+		"no-match": {
+			config:      datalakeDataSourceModel{ProjectId: types.StringValue(projectID), Name: types.StringValue("missing")},
+			expectError: "No datalake found",
+		},
+		"multiple-matches": {
+			config:      datalakeDataSourceModel{ProjectId: types.StringValue(projectID), Name: types.StringValue("shared")},
+			expectError: "Multiple datalakes found",
+		},
+	}, func(t *testing.T, state datalakeDataSourceModel, expectedID string) {
+		if state.ID.ValueString() != expectedID {
+			t.Errorf("expected datalake %q, got %q", expectedID, state.ID.ValueString())
+		}
+		if state.EnvironmentNativeID.ValueString() != "analytics-prod" || state.Region.ValueString() != "us-central1" {
+			t.Errorf("expected environment_native_id and region to be set, got %q and %q", state.EnvironmentNativeID.ValueString(), state.Region.ValueString())
+		}
+	})
+}
+func TestUnitDatalakeDataSource(t *testing.T) {
+	f := newFakeAPI(t)
+	account := f.seed(t, "production")
+	f.seed(t, "staging")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: f.providerConfig() + `
+data "traceforce_datalake" "by_id" {
+  id = "` + account.datalakeID + `"
+}
+
+data "traceforce_datalake" "by_name" {
+  project_id = "` + account.projectID + `"
+  name       = "production-warehouse"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.traceforce_datalake.by_id", "project_id", account.projectID),
+					resource.TestCheckResourceAttr("data.traceforce_datalake.by_id", "name", "production-warehouse"),
+					resource.TestCheckResourceAttr("data.traceforce_datalake.by_id", "type", "bigquery"),
+					resource.TestCheckResourceAttr("data.traceforce_datalake.by_id", "status", "ready"),
+					resource.TestCheckResourceAttr("data.traceforce_datalake.by_id", "region", "us-central1"),
+					resource.TestCheckResourceAttr("data.traceforce_datalake.by_name", "id", account.datalakeID),
+				),
+			},
+		},
+	})
+}
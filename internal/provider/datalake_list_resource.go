@@ -0,0 +1,88 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &datalakeListResource{}
+	_ list.ListResourceWithConfigure = &datalakeListResource{}
+)
+
+// NewDatalakeListResource creates a new datalake list resource.
+func NewDatalakeListResource() list.ListResource {
+	return &datalakeListResource{}
+}
+
+// datalakeListResource lists existing datalakes so that terraform query can
+// generate configuration and import blocks for them. It shares its metadata
+// and configuration with the datalake resource.
+type datalakeListResource struct {
+	datalakeResource
+}
+
+// datalakeListModel maps the datalake list configuration.
+type datalakeListModel struct {
+	ProjectId types.String `tfsdk:"project_id"`
+}
+
+// ListResourceConfigSchema defines the schema for list blocks.
+func (r *datalakeListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the datalakes of the account.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "Only list datalakes in this project.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// List streams the datalakes matching the list configuration.
+func (r *datalakeListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config datalakeListModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var datalakes []traceforce.Datalake
+	var err error
+	if !config.ProjectId.IsNull() {
+		datalakes, err = r.client.GetDatalakesByHostingEnvironment(ctx, config.ProjectId.ValueString())
+	} else {
+		datalakes, err = r.client.GetDatalakes(ctx)
+	}
+	if err != nil {
+		stream.Results = listError("Error reading datalakes", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, datalakes, func(datalake traceforce.Datalake) (string, any, any) {
+		return datalake.Name, idIdentityModel{ID: types.StringValue(datalake.ID)}, datalakeResourceModel{
+			ID:                  types.StringValue(datalake.ID),
+			ProjectId:           types.StringValue(datalake.HostingEnvironmentID),
+			Type:                types.StringValue(string(datalake.Type)),
+			Name:                types.StringValue(datalake.Name),
+			Status:              types.StringValue(string(datalake.Status)),
+			EnvironmentNativeID: types.StringValue(datalake.EnvironmentNativeID),
+			Region:              types.StringValue(datalake.Region),
+			CreatedAt:           types.StringValue(datalake.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt:           types.StringValue(datalake.UpdatedAt.Format(time.RFC3339)),
+			Timeouts:            nullTimeouts("create"),
+		}
+	})
+}
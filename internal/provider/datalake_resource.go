@@ -5,23 +5,33 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	traceforce "github.com/traceforce/traceforce-go-sdk"
 )
 
+// defaultDatalakeTimeout bounds how long Create waits for a datalake to become
+// ready when no timeout is configured.
+const defaultDatalakeTimeout = 30 * time.Minute
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource                = &datalakeResource{}
 	_ resource.ResourceWithConfigure   = &datalakeResource{}
 	_ resource.ResourceWithImportState = &datalakeResource{}
+	_ resource.ResourceWithIdentity    = &datalakeResource{}
 )
 
 // NewDatalakeResource creates a new datalake resource.
@@ -31,20 +41,22 @@ func NewDatalakeResource() resource.Resource {
 
 // datalakeResource is the resource implementation.
 type datalakeResource struct {
-	client *traceforce.Client
+	client *apiClient
 }
 
 // datalakeResourceModel maps datalakes schema data.
 type datalakeResourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	ProjectId           types.String `tfsdk:"project_id"`
-	Type                types.String `tfsdk:"type"`
-	Name                types.String `tfsdk:"name"`
-	Status              types.String `tfsdk:"status"`
-	EnvironmentNativeID types.String `tfsdk:"environment_native_id"`
-	Region              types.String `tfsdk:"region"`
-	CreatedAt           types.String `tfsdk:"created_at"`
-	UpdatedAt           types.String `tfsdk:"updated_at"`
+	ID                  types.String   `tfsdk:"id"`
+	ProjectId           types.String   `tfsdk:"project_id"`
+	Type                types.String   `tfsdk:"type"`
+	Name                types.String   `tfsdk:"name"`
+	Status              types.String   `tfsdk:"status"`
+	EnvironmentNativeID types.String   `tfsdk:"environment_native_id"`
+	Region              types.String   `tfsdk:"region"`
+	CreatedAt           types.String   `tfsdk:"created_at"`
+	UpdatedAt           types.String   `tfsdk:"updated_at"`
+	WaitForReady        types.Bool     `tfsdk:"wait_for_ready"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -59,7 +71,7 @@ func (r *datalakeResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*traceforce.Client)
+	client, ok := req.ProviderData.(*apiClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
@@ -74,7 +86,7 @@ func (r *datalakeResource) Configure(ctx context.Context, req resource.Configure
 }
 
 // Schema defines the schema for the resource.
-func (r *datalakeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *datalakeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"project_id": schema.StringAttribute{
@@ -88,6 +100,9 @@ func (r *datalakeResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: fmt.Sprintf("Type of datalake. Currently supported: %s.",
 					traceforce.DatalakeTypeBigQuery),
 				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(stringValues(traceforce.DatalakeTypeBigQuery)...),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -110,6 +125,15 @@ func (r *datalakeResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"wait_for_ready": schema.BoolAttribute{
+				Description: fmt.Sprintf("Whether to wait for the datalake to become %s after it is created. "+
+					"The apply fails if the datalake becomes %s instead. "+
+					"When enabled, a warning is also reported if a %s datalake later leaves that status. Defaults to false.",
+					traceforce.DatalakeStatusReady,
+					traceforce.DatalakeStatusFailed,
+					traceforce.DatalakeStatusReady),
+				Optional: true,
+			},
 			// The following attributes are computed and should never be reflected in changes.
 			"status": schema.StringAttribute{
 				Description: fmt.Sprintf("Status of the datalake. Valid values: %s, %s, %s, %s.",
@@ -144,9 +168,19 @@ func (r *datalakeResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *datalakeResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("datalake")
+}
+
 func (r *datalakeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan datalakeResourceModel
 
@@ -164,12 +198,26 @@ func (r *datalakeResource) Create(ctx context.Context, req resource.CreateReques
 		Region:               plan.Region.ValueString(),
 	}
 
-	datalake, err := r.client.CreateDatalake(input)
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultDatalakeTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datalake, err := r.client.CreateDatalake(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating datalake", err.Error())
+		resp.Diagnostics.Append(apiNameErrorDiagnostic("Error creating datalake", err))
 		return
 	}
 
+	if plan.WaitForReady.ValueBool() {
+		datalake, err = r.waitForReady(ctx, datalake, createTimeout)
+		if err != nil {
+			// Keep the created datalake in state so it is tainted rather than orphaned.
+			resp.Diagnostics.Append(apiErrorDiagnostic("Error waiting for datalake to become ready", err))
+		}
+	}
+
 	plan = datalakeResourceModel{
 		ID:                  types.StringValue(datalake.ID),
 		ProjectId:           types.StringValue(datalake.HostingEnvironmentID),
@@ -180,10 +228,13 @@ func (r *datalakeResource) Create(ctx context.Context, req resource.CreateReques
 		Region:              types.StringValue(datalake.Region),
 		CreatedAt:           types.StringValue(datalake.CreatedAt.Format(time.RFC3339)),
 		UpdatedAt:           types.StringValue(datalake.UpdatedAt.Format(time.RFC3339)),
+		WaitForReady:        plan.WaitForReady,
+		Timeouts:            plan.Timeouts,
 	}
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentityModel{ID: plan.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -198,12 +249,24 @@ func (r *datalakeResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	datalake, err := r.client.GetDatalake(state.ID.ValueString())
+	datalake, err := r.client.GetDatalake(ctx, state.ID.ValueString())
+	if removeIfNotFound(ctx, err, resp, "datalake", state.ID.ValueString()) {
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading datalake", err.Error())
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error reading datalake", err))
 		return
 	}
 
+	if state.WaitForReady.ValueBool() &&
+		state.Status.ValueString() == string(traceforce.DatalakeStatusReady) &&
+		datalake.Status != traceforce.DatalakeStatusReady {
+		resp.Diagnostics.AddWarning(
+			"Datalake is no longer ready",
+			fmt.Sprintf("Datalake %q was %s but is now %s.", datalake.ID, traceforce.DatalakeStatusReady, datalake.Status),
+		)
+	}
+
 	state = datalakeResourceModel{
 		ID:                  types.StringValue(datalake.ID),
 		ProjectId:           types.StringValue(datalake.HostingEnvironmentID),
@@ -214,10 +277,13 @@ func (r *datalakeResource) Read(ctx context.Context, req resource.ReadRequest, r
 		Region:              types.StringValue(datalake.Region),
 		CreatedAt:           types.StringValue(datalake.CreatedAt.Format(time.RFC3339)),
 		UpdatedAt:           types.StringValue(datalake.UpdatedAt.Format(time.RFC3339)),
+		WaitForReady:        state.WaitForReady,
+		Timeouts:            state.Timeouts,
 	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentityModel{ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -238,9 +304,9 @@ func (r *datalakeResource) Update(ctx context.Context, req resource.UpdateReques
 		Name: &name,
 	}
 
-	datalake, err := r.client.UpdateDatalake(plan.ID.ValueString(), input)
+	datalake, err := r.client.UpdateDatalake(ctx, plan.ID.ValueString(), input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating datalake", err.Error())
+		resp.Diagnostics.Append(apiNameErrorDiagnostic("Error updating datalake", err))
 		return
 	}
 
@@ -254,10 +320,13 @@ func (r *datalakeResource) Update(ctx context.Context, req resource.UpdateReques
 		Region:              types.StringValue(datalake.Region),
 		CreatedAt:           types.StringValue(datalake.CreatedAt.Format(time.RFC3339)),
 		UpdatedAt:           types.StringValue(datalake.UpdatedAt.Format(time.RFC3339)),
+		WaitForReady:        plan.WaitForReady,
+		Timeouts:            plan.Timeouts,
 	}
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentityModel{ID: plan.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -272,14 +341,74 @@ func (r *datalakeResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	err := r.client.DeleteDatalake(state.ID.ValueString())
+	err := r.client.DeleteDatalake(ctx, state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting datalake", err.Error())
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error deleting datalake", err))
 		return
 	}
 }
 
+// waitForReady polls the datalake until it becomes ready, fails, or the
+// timeout expires. The latest known datalake is returned even when waiting
+// fails.
+func (r *datalakeResource) waitForReady(ctx context.Context, datalake *traceforce.Datalake, timeout time.Duration) (*traceforce.Datalake, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	latest, err := waitForStatus(ctx, func() (*traceforce.Datalake, string, error) {
+		current, err := r.client.GetDatalake(ctx, datalake.ID)
+		if err != nil {
+			return nil, "", err
+		}
+		return current, string(current.Status), nil
+	}, string(traceforce.DatalakeStatusReady), []string{string(traceforce.DatalakeStatusFailed)})
+	if latest == nil {
+		latest = datalake
+	}
+
+	var statusErr *unexpectedStatusError
+	if errors.As(err, &statusErr) {
+		return latest, fmt.Errorf("datalake %q deployment failed with status %s", latest.ID, latest.Status)
+	}
+
+	return latest, err
+}
+
+// ImportState imports a datalake by its ID, or by its name within a project in
+// the form <project_id>/<name>.
 func (r *datalakeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import datalake by id
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	projectID, name, ok := strings.Cut(req.ID, "/")
+	if !ok {
+		// Import datalake by id, given either as the import ID or as
+		// the identity
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+	if projectID == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a datalake ID or an import identifier with format <project_id>/<name>, got: %q", req.ID),
+		)
+		return
+	}
+
+	datalakes, err := r.client.GetDatalakesByHostingEnvironment(ctx, projectID)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error reading datalakes by hosting environment", err))
+		return
+	}
+
+	var matches []traceforce.Datalake
+	for _, candidate := range datalakes {
+		if candidate.Name == name {
+			matches = append(matches, candidate)
+		}
+	}
+
+	description := fmt.Sprintf("name %q in project %q", name, projectID)
+	match, ok := lookupOne(matches, func(datalake traceforce.Datalake) string { return datalake.ID }, "datalake", description, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), match.ID)...)
 }
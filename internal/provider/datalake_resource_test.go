@@ -4,10 +4,16 @@
 package provider
 
 import (
+	"net/http"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
 )
 
 func TestAccDatalakeResource(t *testing.T) {
@@ -26,20 +32,20 @@ func TestAccDatalakeResource(t *testing.T) {
 				Config: providerConfig + `
 resource "traceforce_project" "test" {
   name           = "` + projectName + `"
-  type           = "Customer Managed"
-  cloud_provider = "GCP"
+  type           = "customer_managed"
+  cloud_provider = "gcp"
   native_id      = "my-gcp-project"
 }
 
 resource "traceforce_datalake" "test" {
   project_id = traceforce_project.test.id
-  type       = "BigQuery"
+  type       = "bigquery"
   name       = "` + datalakeName + `"
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify attributes
-					resource.TestCheckResourceAttr("traceforce_datalake.test", "type", "BigQuery"),
+					resource.TestCheckResourceAttr("traceforce_datalake.test", "type", "bigquery"),
 					resource.TestCheckResourceAttr("traceforce_datalake.test", "name", datalakeName),
 					resource.TestCheckResourceAttrSet("traceforce_datalake.test", "project_id"),
 					// Verify dynamic values have any value set in the state.
@@ -57,29 +63,276 @@ resource "traceforce_datalake" "test" {
 				// The updated_at attribute may not match during import
 				ImportStateVerifyIgnore: []string{"updated_at"},
 			},
+			// ImportState testing by project ID and name
+			{
+				ResourceName:            "traceforce_datalake.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccImportStateIDFunc("traceforce_datalake.test", "project_id", "name"),
+				ImportStateVerifyIgnore: []string{"updated_at"},
+			},
 			// Update and Read testing
 			{
 				Config: providerConfig + `
 resource "traceforce_project" "test" {
   name           = "` + projectName + `"
-  type           = "Customer Managed"
-  cloud_provider = "GCP"
+  type           = "customer_managed"
+  cloud_provider = "gcp"
   native_id      = "my-gcp-project"
 }
 
 resource "traceforce_datalake" "test" {
   project_id = traceforce_project.test.id
-  type       = "BigQuery"
+  type       = "bigquery"
   name       = "` + datalakeName + `-updated"
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify name was updated
 					resource.TestCheckResourceAttr("traceforce_datalake.test", "name", datalakeName+"-updated"),
-					resource.TestCheckResourceAttr("traceforce_datalake.test", "type", "BigQuery"),
+					resource.TestCheckResourceAttr("traceforce_datalake.test", "type", "bigquery"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
+
+func TestDatalakeResourceWaitForReady(t *testing.T) {
+	setStatusPollInterval(t, time.Millisecond)
+
+	testCases := map[string]struct {
+		finalStatus    traceforce.DatalakeStatus
+		expectError    string
+		expectedStatus string
+	}{
+		"ready": {
+			finalStatus:    traceforce.DatalakeStatusReady,
+			expectedStatus: "ready",
+		},
+		"failed": {
+			finalStatus:    traceforce.DatalakeStatusFailed,
+			expectError:    "deployment failed with status failed",
+			expectedStatus: "failed",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			f := newFakeAPI(t)
+			projectID := f.seed(t, "production").projectID
+
+			// The datalake is pending on creation and deployed on the first
+			// poll, then reaches its final status.
+			f.transition("test", "pending", "deployed", string(testCase.finalStatus))
+
+			resp := f.createResource(t, NewDatalakeResource(), &datalakeResourceModel{
+				ID:                  types.StringUnknown(),
+				ProjectId:           types.StringValue(projectID),
+				Type:                types.StringValue(string(traceforce.DatalakeTypeBigQuery)),
+				Name:                types.StringValue("test"),
+				Status:              types.StringUnknown(),
+				EnvironmentNativeID: types.StringValue("my-gcp-project"),
+				Region:              types.StringValue("us-central1"),
+				CreatedAt:           types.StringUnknown(),
+				UpdatedAt:           types.StringUnknown(),
+				WaitForReady:        types.BoolValue(true),
+				Timeouts:            nullTimeouts("create"),
+			})
+
+			if testCase.expectError == "" && resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+			if testCase.expectError != "" {
+				errs := resp.Diagnostics.Errors()
+				if len(errs) != 1 || !strings.Contains(errs[0].Detail(), testCase.expectError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.expectError, resp.Diagnostics)
+				}
+			}
+
+			var state datalakeResourceModel
+			if diags := resp.State.Get(t.Context(), &state); diags.HasError() {
+				t.Fatalf("unexpected state diagnostics: %v", diags)
+			}
+			if len(f.datalakes) != 2 || state.ID.ValueString() != f.datalakes[1].ID {
+				t.Errorf("expected created datalake to be kept in state, got %q", state.ID.ValueString())
+			}
+			if state.Status.ValueString() != testCase.expectedStatus {
+				t.Errorf("expected status %q, got %q", testCase.expectedStatus, state.Status.ValueString())
+			}
+		})
+	}
+}
+func TestDatalakeResourceReadWarnsOnReadyRegression(t *testing.T) {
+	f := newFakeAPI(t)
+	datalakeID := uuid.New().String()
+	f.add(traceforce.Datalake{ID: datalakeID, Status: traceforce.DatalakeStatusFailed})
+
+	resp := f.readResource(t, NewDatalakeResource(), &datalakeResourceModel{
+		ID:           types.StringValue(datalakeID),
+		Status:       types.StringValue(string(traceforce.DatalakeStatusReady)),
+		WaitForReady: types.BoolValue(true),
+		Timeouts:     nullTimeouts("create"),
+	})
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+	}
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), "is now failed") {
+		t.Errorf("expected regression warning with the new status, got: %v", resp.Diagnostics)
+	}
+}
+func TestDatalakeResourceImportState(t *testing.T) {
+	f := newFakeAPI(t)
+	projectID := uuid.New().String()
+	f.add(
+		traceforce.Datalake{ID: "datalake-1", HostingEnvironmentID: projectID, Name: "analytics"},
+		traceforce.Datalake{ID: "datalake-2", HostingEnvironmentID: projectID, Name: "warehouse"},
+		traceforce.Datalake{ID: "datalake-3", HostingEnvironmentID: uuid.New().String(), Name: "analytics"},
+	)
+
+	testCases := map[string]struct {
+		importID    string
+		expectedID  string
+		expectError bool
+	}{
+		"id": {
+			importID:   "datalake-2",
+			expectedID: "datalake-2",
+		},
+		"project-and-name": {
+			importID:   projectID + "/analytics",
+			expectedID: "datalake-1",
+		},
+		"name-not-found": {
+			importID:    projectID + "/missing",
+			expectError: true,
+		},
+		"missing-name": {
+			importID:    projectID + "/",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := &datalakeResource{client: f.client()}
+
+			if id := testImportState(t, r, testCase.importID, testCase.expectError); id != testCase.expectedID {
+				t.Errorf("expected id %q, got %q", testCase.expectedID, id)
+			}
+		})
+	}
+}
+func TestDatalakeResourceLifecycle(t *testing.T) {
+	f := newFakeAPI(t)
+	projectID := f.seed(t, "production").projectID
+
+	testResourceLifecycle(t, f, NewDatalakeResource, lifecycleTestCase[datalakeResourceModel]{
+		create: datalakeResourceModel{
+			ID:                  types.StringUnknown(),
+			ProjectId:           types.StringValue(projectID),
+			Type:                types.StringValue(string(traceforce.DatalakeTypeBigQuery)),
+			Name:                types.StringValue("analytics"),
+			Status:              types.StringUnknown(),
+			EnvironmentNativeID: types.StringValue("my-gcp-project"),
+			Region:              types.StringValue("us-central1"),
+			CreatedAt:           types.StringUnknown(),
+			UpdatedAt:           types.StringUnknown(),
+			WaitForReady:        types.BoolValue(true),
+			Timeouts:            nullTimeouts("create"),
+		},
+		update: func(state datalakeResourceModel) datalakeResourceModel {
+			state.Name = types.StringValue("analytics-updated")
+			state.UpdatedAt = types.StringUnknown()
+			return state
+		},
+		check: func(t *testing.T, step string, state datalakeResourceModel) {
+			expectedName := map[string]string{"create": "analytics", "update": "analytics-updated"}[step]
+			if state.Name.ValueString() != expectedName {
+				t.Errorf("%s: expected name %q, got %q", step, expectedName, state.Name.ValueString())
+			}
+			if state.ProjectId.ValueString() != projectID || state.Status.ValueString() != "ready" {
+				t.Errorf("%s: expected a ready datalake in project %q, got %q in %q", step, projectID, state.Status.ValueString(), state.ProjectId.ValueString())
+			}
+			if state.EnvironmentNativeID.ValueString() != "my-gcp-project" || state.Region.ValueString() != "us-central1" {
+				t.Errorf("%s: expected environment_native_id and region to be set, got %q and %q", step, state.EnvironmentNativeID.ValueString(), state.Region.ValueString())
+			}
+		},
+	})
+}
+
+func TestUnitDatalakeResource(t *testing.T) {
+	f := newFakeAPI(t)
+
+	config := func(name string) string {
+		return f.providerConfig() + `
+resource "traceforce_project" "test" {
+  name           = "production"
+  type           = "customer_managed"
+  cloud_provider = "gcp"
+  native_id      = "my-gcp-project"
+}
+
+resource "traceforce_datalake" "test" {
+  project_id            = traceforce_project.test.id
+  type                  = "bigquery"
+  name                  = "` + name + `"
+  environment_native_id = "my-gcp-project"
+  region                = "us-central1"
+  wait_for_ready        = true
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             f.checkDestroyed,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config("analytics"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("traceforce_datalake.test", "project_id", "traceforce_project.test", "id"),
+					resource.TestCheckResourceAttr("traceforce_datalake.test", "type", "bigquery"),
+					resource.TestCheckResourceAttr("traceforce_datalake.test", "name", "analytics"),
+					resource.TestCheckResourceAttr("traceforce_datalake.test", "environment_native_id", "my-gcp-project"),
+					resource.TestCheckResourceAttr("traceforce_datalake.test", "region", "us-central1"),
+					resource.TestCheckResourceAttr("traceforce_datalake.test", "status", "ready"),
+					resource.TestCheckResourceAttrSet("traceforce_datalake.test", "id"),
+					resource.TestCheckResourceAttrSet("traceforce_datalake.test", "created_at"),
+					resource.TestCheckResourceAttrSet("traceforce_datalake.test", "updated_at"),
+				),
+			},
+			// ImportState testing by ID and by project ID and name
+			{
+				ResourceName:            "traceforce_datalake.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_ready"},
+			},
+			{
+				ResourceName:            "traceforce_datalake.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccImportStateIDFunc("traceforce_datalake.test", "project_id", "name"),
+				ImportStateVerifyIgnore: []string{"wait_for_ready"},
+			},
+			// Update and Read testing
+			{
+				Config: config("analytics-updated"),
+				Check:  resource.TestCheckResourceAttr("traceforce_datalake.test", "name", "analytics-updated"),
+			},
+			// Update errors are reported
+			{
+				PreConfig: func() {
+					f.fail(http.MethodPatch, "/datalakes/", http.StatusInternalServerError, 1)
+				},
+				Config:      config("analytics"),
+				ExpectError: regexp.MustCompile(`Error updating datalake`),
+			},
+		},
+	})
+}
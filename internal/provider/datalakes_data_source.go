@@ -25,11 +25,12 @@ func NewDatalakesDataSource() datasource.DataSource {
 }
 
 type datalakesDataSource struct {
-	client *traceforce.Client
+	client *apiClient
 }
 
 // datalakesDataSourceModel maps the data source schema data.
 type datalakesDataSourceModel struct {
+	Filter    *filterModel     `tfsdk:"filter"`
 	ProjectId types.String     `tfsdk:"project_id"`
 	Datalakes []datalakesModel `tfsdk:"datalakes"`
 }
@@ -57,11 +58,11 @@ func (d *datalakesDataSource) Configure(_ context.Context, req datasource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*traceforce.Client)
+	client, ok := req.ProviderData.(*apiClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *traceforce.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -118,6 +119,11 @@ func (d *datalakesDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": filterBlock("datalake",
+				stringValues(traceforce.DatalakeStatusPending, traceforce.DatalakeStatusDeployed, traceforce.DatalakeStatusReady, traceforce.DatalakeStatusFailed),
+				stringValues(traceforce.DatalakeTypeBigQuery)),
+		},
 	}
 }
 
@@ -130,29 +136,52 @@ func (d *datalakesDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
+	filter, diags := parseFilter(config.Filter)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var datalakes []traceforce.Datalake
 	var err error
 
 	if !config.ProjectId.IsNull() {
 		// Get datalakes filtered by hosting environment (project) ID
-		datalakes, err = d.client.GetDatalakesByHostingEnvironment(config.ProjectId.ValueString())
+		datalakes, err = d.client.GetDatalakesByHostingEnvironment(ctx, config.ProjectId.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Error reading datalakes by hosting environment", err.Error())
+			resp.Diagnostics.Append(apiErrorDiagnostic("Error reading datalakes by hosting environment", err))
 			return
 		}
 	} else {
 		// Get all datalakes
-		datalakes, err = d.client.GetDatalakes()
+		datalakes, err = d.client.GetDatalakes(ctx)
 		if err != nil {
-			resp.Diagnostics.AddError("Error reading datalakes", err.Error())
+			resp.Diagnostics.Append(apiErrorDiagnostic("Error reading datalakes", err))
 			return
 		}
 	}
 
-	var state datalakesDataSourceModel
-	state.ProjectId = config.ProjectId
+	// Datalakes only reference their project, so its cloud provider has to be
+	// looked up to filter by cloud provider
+	var cloudProviders map[string]string
+	if filter.byCloudProvider() {
+		cloudProviders, err = projectCloudProviders(ctx, d.client)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic("Error reading hosting environments", err))
+			return
+		}
+	}
+
+	state := datalakesDataSourceModel{
+		Filter:    config.Filter,
+		ProjectId: config.ProjectId,
+		Datalakes: []datalakesModel{},
+	}
 
 	for _, datalake := range datalakes {
+		if !filter.matches(datalake.Name, string(datalake.Status), string(datalake.Type), cloudProviders[datalake.HostingEnvironmentID], datalake.CreatedAt) {
+			continue
+		}
 		state.Datalakes = append(state.Datalakes, datalakesModel{
 			ID:        types.StringValue(datalake.ID),
 			ProjectId: types.StringValue(datalake.HostingEnvironmentID),
@@ -6,7 +6,10 @@ package provider
 import (
 	"testing"
 
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
 )
 
 func TestAccDatalakesDataSource(t *testing.T) {
@@ -20,7 +23,7 @@ func TestAccDatalakesDataSource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify the first datalake to ensure all attributes are set
 					resource.TestCheckResourceAttr("data.traceforce_datalakes.test", "datalakes.0.name", "production-warehouse"),
-					resource.TestCheckResourceAttr("data.traceforce_datalakes.test", "datalakes.0.type", "BigQuery"),
+					resource.TestCheckResourceAttr("data.traceforce_datalakes.test", "datalakes.0.type", "bigquery"),
 					resource.TestCheckResourceAttr("data.traceforce_datalakes.test", "datalakes.0.project_id", "project-1"),
 					resource.TestCheckResourceAttrSet("data.traceforce_datalakes.test", "datalakes.0.status"),
 					resource.TestCheckResourceAttrSet("data.traceforce_datalakes.test", "datalakes.0.id"),
@@ -44,3 +47,73 @@ data "traceforce_datalakes" "project_filtered" {
 		},
 	})
 }
+
+func TestDatalakesDataSourceFilter(t *testing.T) {
+	f := newFakeAPI(t)
+	gcpProject := traceforce.HostingEnvironment{ID: uuid.New().String(), CloudProvider: traceforce.CloudProviderGCP}
+	awsProject := traceforce.HostingEnvironment{ID: uuid.New().String(), CloudProvider: traceforce.CloudProviderAWS}
+	f.add(
+		gcpProject,
+		awsProject,
+		traceforce.Datalake{ID: uuid.New().String(), HostingEnvironmentID: gcpProject.ID, Name: "gcp-failed", Status: traceforce.DatalakeStatusFailed},
+		traceforce.Datalake{ID: uuid.New().String(), HostingEnvironmentID: gcpProject.ID, Name: "gcp-ready", Status: traceforce.DatalakeStatusReady},
+		traceforce.Datalake{ID: uuid.New().String(), HostingEnvironmentID: awsProject.ID, Name: "aws-failed", Status: traceforce.DatalakeStatusFailed},
+	)
+
+	resp := f.readDataSource(t, NewDatalakesDataSource(), &datalakesDataSourceModel{
+		ProjectId: types.StringNull(),
+		Filter: &filterModel{
+			Status:        types.StringValue("failed"),
+			CloudProvider: types.StringValue("gcp"),
+		},
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+	}
+
+	var state datalakesDataSourceModel
+	if diags := resp.State.Get(t.Context(), &state); diags.HasError() {
+		t.Fatalf("unexpected state diagnostics: %v", diags)
+	}
+	if len(state.Datalakes) != 1 || state.Datalakes[0].Name.ValueString() != "gcp-failed" {
+		t.Errorf("expected only the failed GCP datalake, got: %v", state.Datalakes)
+	}
+}
+
+func TestUnitDatalakesDataSource(t *testing.T) {
+	f := newFakeAPI(t)
+	production := f.seed(t, "production")
+	staging := f.seed(t, "staging")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: f.providerConfig() + `
+data "traceforce_datalakes" "all" {}
+
+data "traceforce_datalakes" "project_filtered" {
+  project_id = "` + staging.projectID + `"
+}
+
+data "traceforce_datalakes" "name_filtered" {
+  filter {
+    name_regex = "^staging-"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.traceforce_datalakes.all", "datalakes.#", "2"),
+					resource.TestCheckResourceAttr("data.traceforce_datalakes.all", "datalakes.0.id", production.datalakeID),
+					resource.TestCheckResourceAttr("data.traceforce_datalakes.project_filtered", "datalakes.#", "1"),
+					resource.TestCheckResourceAttr("data.traceforce_datalakes.project_filtered", "datalakes.0.id", staging.datalakeID),
+					resource.TestCheckResourceAttr("data.traceforce_datalakes.project_filtered", "datalakes.0.name", "staging-warehouse"),
+					resource.TestCheckResourceAttr("data.traceforce_datalakes.project_filtered", "datalakes.0.project_id", staging.projectID),
+					resource.TestCheckResourceAttr("data.traceforce_datalakes.name_filtered", "datalakes.#", "1"),
+					resource.TestCheckResourceAttr("data.traceforce_datalakes.name_filtered", "datalakes.0.id", staging.datalakeID),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,209 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// requestIDHeader is the response header carrying the ID the Traceforce API
+// assigned to a request.
+const requestIDHeader = "X-Request-Id"
+
+// apiError is an error response returned by the Traceforce API.
+type apiError struct {
+	StatusCode int
+	Body       string
+
+	// RequestID identifies the request in the Traceforce API logs, if the
+	// API returned one.
+	RequestID string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("HTTP %d: %s", e.StatusCode, e.Body)
+}
+
+// asAPIError extracts the API error response from err, if any.
+func asAPIError(err error) (*apiError, bool) {
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+
+	return nil, false
+}
+
+// isNotFound reports whether err is a not found response from the Traceforce API.
+func isNotFound(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+// removeIfNotFound removes the resource from state with a warning when err
+// reports that the remote object no longer exists, so Terraform plans to
+// recreate it instead of failing. It returns true if the error was handled.
+func removeIfNotFound(ctx context.Context, err error, resp *resource.ReadResponse, kind, id string) bool {
+	if !isNotFound(err) {
+		return false
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Resource not found",
+		fmt.Sprintf("The %s with ID %q no longer exists and has been removed from the Terraform state. "+
+			"It will be recreated on the next apply if it is still present in the configuration.", kind, id),
+	)
+	resp.State.RemoveResource(ctx)
+
+	return true
+}
+
+// message returns the error message of the API response, falling back to the
+// raw response body when it is not a JSON error object.
+func (e *apiError) message() string {
+	var body struct {
+		Error   string `json:"error"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal([]byte(e.Body), &body); err == nil {
+		if body.Error != "" {
+			return body.Error
+		}
+		if body.Message != "" {
+			return body.Message
+		}
+	}
+
+	return strings.TrimSpace(e.Body)
+}
+
+// requestID returns the ID of the failed request, taken from the response
+// header or, failing that, from the response body.
+func (e *apiError) requestID() string {
+	if e.RequestID != "" {
+		return e.RequestID
+	}
+
+	var body struct {
+		RequestID string `json:"request_id"`
+	}
+	if err := json.Unmarshal([]byte(e.Body), &body); err != nil {
+		return ""
+	}
+	return body.RequestID
+}
+
+// apiErrorClass describes a class of API error responses.
+type apiErrorClass struct {
+	// summary is appended to the summary of the failed operation.
+	summary string
+
+	// hint tells the practitioner how to resolve the error.
+	hint string
+}
+
+// classifyAPIError returns the class of an API error response.
+func classifyAPIError(apiErr *apiError, nameConflict bool) apiErrorClass {
+	switch code := apiErr.StatusCode; {
+	case code == http.StatusUnauthorized:
+		return apiErrorClass{
+			summary: "authentication failed",
+			hint: "The Traceforce API rejected the API key. Check that the api_key provider argument or the " +
+				"TRACEFORCE_API_KEY environment variable holds a valid, unexpired API key for the configured endpoint.",
+		}
+	case code == http.StatusForbidden:
+		return apiErrorClass{
+			summary: "permission denied",
+			hint:    "The API key is valid but is not allowed to perform this operation. Use an API key with access to the object.",
+		}
+	case code == http.StatusNotFound:
+		return apiErrorClass{
+			summary: "not found",
+			hint:    "The object does not exist. It may have been deleted outside of Terraform, or the configured ID may be wrong.",
+		}
+	case code == http.StatusConflict && nameConflict:
+		return apiErrorClass{
+			summary: "name already in use",
+			hint: "Another object with this name already exists. Choose a different name, " +
+				"or import the existing object to manage it with Terraform.",
+		}
+	case code == http.StatusConflict:
+		return apiErrorClass{
+			summary: "conflict",
+			hint: "The request conflicts with an existing object. If the object already exists, " +
+				"import it to manage it with Terraform.",
+		}
+	case code == http.StatusUnprocessableEntity:
+		return apiErrorClass{
+			summary: "invalid request",
+			hint:    "The Traceforce API rejected the configured values. Correct the configuration according to the API message.",
+		}
+	case code == http.StatusTooManyRequests:
+		return apiErrorClass{
+			summary: "rate limited",
+			hint: "Too many requests were sent to the Traceforce API. Retry later, or increase the max_retries " +
+				"provider argument so that rate limited requests are retried automatically.",
+		}
+	case code >= http.StatusInternalServerError:
+		return apiErrorClass{
+			summary: "Traceforce API unavailable",
+			hint: "The Traceforce API failed to process the request. This is usually temporary, so retry later. " +
+				"If the error persists, contact Traceforce support with the request ID.",
+		}
+	default:
+		return apiErrorClass{
+			summary: "unexpected API response",
+			hint:    "The Traceforce API returned an unexpected error.",
+		}
+	}
+}
+
+// apiErrorDiagnostic translates an error returned by the API client into an
+// error diagnostic. The summary describes the failed operation, such as
+// "Error creating datalake". API error responses get a summary and hint
+// tailored to their status code, along with the API message and request ID.
+func apiErrorDiagnostic(summary string, err error) diag.Diagnostic {
+	return translateAPIError(summary, err, false)
+}
+
+// apiNameErrorDiagnostic is apiErrorDiagnostic for operations creating or
+// renaming an object whose name must be unique. Conflicts are reported
+// against the name attribute.
+func apiNameErrorDiagnostic(summary string, err error) diag.Diagnostic {
+	return translateAPIError(summary, err, true)
+}
+
+func translateAPIError(summary string, err error, nameConflict bool) diag.Diagnostic {
+	apiErr, ok := asAPIError(err)
+	if !ok {
+		return diag.NewErrorDiagnostic(summary, err.Error())
+	}
+
+	class := classifyAPIError(apiErr, nameConflict)
+
+	var detail strings.Builder
+	detail.WriteString(class.hint)
+	fmt.Fprintf(&detail, "\n\nAPI response (HTTP %d)", apiErr.StatusCode)
+	if message := apiErr.message(); message != "" {
+		fmt.Fprintf(&detail, ": %s", message)
+	}
+	if requestID := apiErr.requestID(); requestID != "" {
+		fmt.Fprintf(&detail, "\nRequest ID: %s", requestID)
+	}
+
+	summary = summary + ": " + class.summary
+	if nameConflict && apiErr.StatusCode == http.StatusConflict {
+		return diag.NewAttributeErrorDiagnostic(path.Root("name"), summary, detail.String())
+	}
+	return diag.NewErrorDiagnostic(summary, detail.String())
+}
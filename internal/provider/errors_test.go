@@ -0,0 +1,221 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsNotFound(t *testing.T) {
+	f := newFakeAPI(t)
+	client := f.client()
+
+	_, err := client.GetDatalake(t.Context(), missingID)
+	if !isNotFound(err) {
+		t.Errorf("expected not found error, got: %v", err)
+	}
+
+	f.fail(http.MethodGet, "/datalakes/", http.StatusInternalServerError, 1)
+	_, err = client.GetDatalake(t.Context(), uuid.New().String())
+	if isNotFound(err) {
+		t.Errorf("expected server error not to be treated as not found, got: %v", err)
+	}
+
+	apiErr, ok := asAPIError(err)
+	if !ok {
+		t.Fatalf("expected API error, got: %v", err)
+	}
+	if apiErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("expected status code %d, got %d", http.StatusInternalServerError, apiErr.StatusCode)
+	}
+
+	if isNotFound(errors.New("id cannot be empty")) {
+		t.Error("expected client-side error not to be treated as not found")
+	}
+
+	if !isNotFound(fmt.Errorf("wrapped: %w", &apiError{StatusCode: http.StatusNotFound})) {
+		t.Error("expected wrapped 404 error to be treated as not found")
+	}
+}
+
+func TestResourceReadRemovesNotFound(t *testing.T) {
+	f := newFakeAPI(t)
+
+	id := types.StringValue(missingID)
+	testCases := map[string]struct {
+		resource resource.Resource
+		state    any
+	}{
+		"project": {
+			resource: &projectResource{},
+			state:    &projectResourceModel{ID: id, Timeouts: nullTimeouts("create", "update")},
+		},
+		"datalake": {
+			resource: &datalakeResource{},
+			state:    &datalakeResourceModel{ID: id, Timeouts: nullTimeouts("create")},
+		},
+		"source_app": {
+			resource: &sourceAppResource{},
+			state:    &sourceAppResourceModel{ID: id},
+		},
+		"source_app_datalake_link": {
+			resource: &sourceAppDatalakeLinkResource{},
+			state:    &sourceAppDatalakeLinkResourceModel{ID: id},
+		},
+		"post_connection": {
+			resource: &postConnectionResource{},
+			state: &postConnectionResourceModel{
+				TraceforceHostingEnvironmentId: id,
+				DeployedDatalakeIds:            types.ListNull(types.StringType),
+				DeployedSourceAppIds:           types.ListNull(types.StringType),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp := f.readResource(t, testCase.resource, testCase.state)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+			if resp.Diagnostics.WarningsCount() != 1 {
+				t.Errorf("expected one warning, got: %v", resp.Diagnostics)
+			}
+			if !resp.State.Raw.IsNull() {
+				t.Error("expected resource to be removed from state")
+			}
+		})
+	}
+}
+
+// missingID is the ID the fake API reports as not found.
+const missingID = "00000000-0000-0000-0000-000000000404"
+
+func TestAPIErrorDiagnostic(t *testing.T) {
+	testCases := map[string]struct {
+		status          int
+		nameConflict    bool
+		expectedSummary string
+		expectedPath    path.Path
+	}{
+		"unauthorized": {
+			status:          http.StatusUnauthorized,
+			expectedSummary: "Error reading datalake: authentication failed",
+		},
+		"forbidden": {
+			status:          http.StatusForbidden,
+			expectedSummary: "Error reading datalake: permission denied",
+		},
+		"not-found": {
+			status:          http.StatusNotFound,
+			expectedSummary: "Error reading datalake: not found",
+		},
+		"conflict": {
+			status:          http.StatusConflict,
+			expectedSummary: "Error reading datalake: conflict",
+		},
+		"name-conflict": {
+			status:          http.StatusConflict,
+			nameConflict:    true,
+			expectedSummary: "Error reading datalake: name already in use",
+			expectedPath:    path.Root("name"),
+		},
+		"name-conflict-other-status": {
+			status:          http.StatusUnprocessableEntity,
+			nameConflict:    true,
+			expectedSummary: "Error reading datalake: invalid request",
+		},
+		"rate-limited": {
+			status:          http.StatusTooManyRequests,
+			expectedSummary: "Error reading datalake: rate limited",
+		},
+		"server-error": {
+			status:          http.StatusBadGateway,
+			expectedSummary: "Error reading datalake: Traceforce API unavailable",
+		},
+		"unexpected": {
+			status:          http.StatusTeapot,
+			expectedSummary: "Error reading datalake: unexpected API response",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			f := newFakeAPI(t)
+			account := f.seed(t, "production")
+			f.fail(http.MethodGet, "/datalakes/", testCase.status, 1)
+
+			_, err := f.client().GetDatalake(t.Context(), account.datalakeID)
+			apiErr, ok := asAPIError(err)
+			if !ok {
+				t.Fatalf("expected API error, got: %v", err)
+			}
+			if !strings.HasPrefix(apiErr.RequestID, "req-") {
+				t.Errorf("expected request ID from the response header, got %q", apiErr.RequestID)
+			}
+
+			var diagnostic diag.Diagnostic
+			if testCase.nameConflict {
+				diagnostic = apiNameErrorDiagnostic("Error reading datalake", err)
+			} else {
+				diagnostic = apiErrorDiagnostic("Error reading datalake", err)
+			}
+
+			if diagnostic.Severity() != diag.SeverityError {
+				t.Errorf("expected error severity, got %s", diagnostic.Severity())
+			}
+			if diagnostic.Summary() != testCase.expectedSummary {
+				t.Errorf("expected summary %q, got %q", testCase.expectedSummary, diagnostic.Summary())
+			}
+			if !strings.Contains(diagnostic.Detail(), "injected failure") {
+				t.Errorf("expected detail to contain the API message, got %q", diagnostic.Detail())
+			}
+			if !strings.Contains(diagnostic.Detail(), "Request ID: "+apiErr.RequestID) {
+				t.Errorf("expected detail to contain the request ID, got %q", diagnostic.Detail())
+			}
+
+			var actualPath path.Path
+			if withPath, ok := diagnostic.(diag.DiagnosticWithPath); ok {
+				actualPath = withPath.Path()
+			}
+			if !actualPath.Equal(testCase.expectedPath) {
+				t.Errorf("expected path %s, got %s", testCase.expectedPath, actualPath)
+			}
+		})
+	}
+}
+
+func TestAPIErrorDiagnosticRequestIDFromBody(t *testing.T) {
+	err := &apiError{
+		StatusCode: http.StatusInternalServerError,
+		Body:       `{"message":"database unavailable","request_id":"req-123"}`,
+	}
+
+	diagnostic := apiErrorDiagnostic("Error creating datalake", err)
+	if !strings.Contains(diagnostic.Detail(), "HTTP 500): database unavailable") {
+		t.Errorf("expected detail to contain the API message, got %q", diagnostic.Detail())
+	}
+	if !strings.Contains(diagnostic.Detail(), "Request ID: req-123") {
+		t.Errorf("expected detail to contain the request ID, got %q", diagnostic.Detail())
+	}
+}
+
+func TestAPIErrorDiagnosticClientError(t *testing.T) {
+	diagnostic := apiErrorDiagnostic("Error reading datalake", errors.New("id cannot be empty"))
+
+	if diagnostic.Summary() != "Error reading datalake" || diagnostic.Detail() != "id cannot be empty" {
+		t.Errorf("expected client-side error to be reported as is, got %q: %q", diagnostic.Summary(), diagnostic.Detail())
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// exportedAccount holds the objects of a Traceforce account to export.
+type exportedAccount struct {
+	projects   []traceforce.HostingEnvironment
+	datalakes  []traceforce.Datalake
+	sourceApps []traceforce.SourceApp
+	links      []traceforce.SourceAppDatalakeLink
+}
+
+// Export writes Terraform configuration for every project, datalake, source
+// app and source app datalake link of the account to dir. Each object gets a
+// resource block and an import block, and objects refer to the resources of
+// the objects they belong to. The account is selected with the same
+// TRACEFORCE_API_KEY and TRACEFORCE_ENDPOINT environment variables as the
+// provider, and requests are retried according to the same
+// TRACEFORCE_MAX_RETRIES, TRACEFORCE_RETRY_MIN_BACKOFF and
+// TRACEFORCE_RETRY_MAX_BACKOFF environment variables. Existing files are never
+// overwritten: if any of the files already exists, nothing is written.
+func Export(ctx context.Context, dir string) error {
+	apiKey := os.Getenv("TRACEFORCE_API_KEY")
+	if apiKey == "" {
+		return errors.New("the TRACEFORCE_API_KEY environment variable must be set to export an account")
+	}
+
+	var diags diag.Diagnostics
+	retry := resolveRetryPolicy(traceforceProviderModel{
+		MaxRetries:      types.Int64Null(),
+		RetryMinBackoff: types.StringNull(),
+		RetryMaxBackoff: types.StringNull(),
+	}, &diags)
+	if diags.HasError() {
+		errs := diags.Errors()
+		return fmt.Errorf("%s: %s", errs[0].Summary(), errs[0].Detail())
+	}
+
+	names := make([]string, 0, len(exportFileNames))
+	for _, name := range exportFileNames {
+		names = append(names, filepath.Join(dir, name))
+	}
+	for _, name := range names {
+		if _, err := os.Lstat(name); err == nil {
+			return fmt.Errorf("%s already exists", name)
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	client, err := newAPIClient(apiKey, os.Getenv("TRACEFORCE_ENDPOINT"), apiClientOptions{
+		Retry: retry,
+	})
+	if err != nil {
+		return err
+	}
+
+	account, err := fetchAccount(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	// Remove the files written so far if a later one fails, so that a failed
+	// export leaves no partial configuration behind.
+	files := renderAccount(account)
+	for i, name := range names {
+		if err := writeNewFile(name, files[exportFileNames[i]]); err != nil {
+			for _, written := range names[:i] {
+				os.Remove(written)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// fetchAccount lists every object of the account.
+func fetchAccount(ctx context.Context, client *apiClient) (exportedAccount, error) {
+	var account exportedAccount
+	var err error
+
+	if account.projects, err = client.GetHostingEnvironments(ctx); err != nil {
+		return account, fmt.Errorf("reading hosting environments: %w", err)
+	}
+	if account.datalakes, err = client.GetDatalakes(ctx); err != nil {
+		return account, fmt.Errorf("reading datalakes: %w", err)
+	}
+	if account.sourceApps, err = client.GetSourceApps(ctx); err != nil {
+		return account, fmt.Errorf("reading source apps: %w", err)
+	}
+	if account.links, err = client.GetSourceAppDatalakeLinks(ctx); err != nil {
+		return account, fmt.Errorf("reading source app datalake links: %w", err)
+	}
+	return account, nil
+}
+
+// exportFileNames are the files written by Export, in the order they are
+// written.
+var exportFileNames = []string{
+	"projects.tf",
+	"datalakes.tf",
+	"source_apps.tf",
+	"source_app_datalake_links.tf",
+}
+
+// renderAccount renders the configuration of an account, keyed by file name.
+func renderAccount(account exportedAccount) map[string][]byte {
+	projects := newResourceNames("traceforce_project")
+	datalakes := newResourceNames("traceforce_datalake")
+	sourceApps := newResourceNames("traceforce_source_app")
+	links := newResourceNames("traceforce_source_app_datalake_link")
+
+	projectsFile := hclwrite.NewEmptyFile()
+	for _, project := range account.projects {
+		body := appendExportedResource(projectsFile.Body(), projects, project.ID, project.Name)
+		body.SetAttributeValue("name", cty.StringVal(project.Name))
+		body.SetAttributeValue("type", cty.StringVal(string(project.Type)))
+		body.SetAttributeValue("cloud_provider", cty.StringVal(string(project.CloudProvider)))
+		body.SetAttributeValue("native_id", cty.StringVal(project.NativeID))
+	}
+
+	datalakesFile := hclwrite.NewEmptyFile()
+	for _, datalake := range account.datalakes {
+		body := appendExportedResource(datalakesFile.Body(), datalakes, datalake.ID, datalake.Name)
+		setReference(body, "project_id", projects, datalake.HostingEnvironmentID)
+		body.SetAttributeValue("type", cty.StringVal(string(datalake.Type)))
+		body.SetAttributeValue("name", cty.StringVal(datalake.Name))
+		body.SetAttributeValue("environment_native_id", cty.StringVal(datalake.EnvironmentNativeID))
+		body.SetAttributeValue("region", cty.StringVal(datalake.Region))
+	}
+
+	sourceAppsFile := hclwrite.NewEmptyFile()
+	for _, sourceApp := range account.sourceApps {
+		body := appendExportedResource(sourceAppsFile.Body(), sourceApps, sourceApp.ID, sourceApp.Name)
+		setReference(body, "hosting_environment_id", projects, sourceApp.HostingEnvironmentID)
+		body.SetAttributeValue("type", cty.StringVal(string(sourceApp.Type)))
+		body.SetAttributeValue("name", cty.StringVal(sourceApp.Name))
+	}
+
+	linksFile := hclwrite.NewEmptyFile()
+	for _, link := range account.links {
+		// Links have no name of their own, so they are named after the
+		// source app and datalake they link
+		name := sourceApps.nameOf(link.SourceAppID) + "_to_" + datalakes.nameOf(link.DatalakeID)
+		body := appendExportedResource(linksFile.Body(), links, link.ID, name)
+		setReference(body, "source_app_id", sourceApps, link.SourceAppID)
+		setReference(body, "datalake_id", datalakes, link.DatalakeID)
+	}
+
+	return map[string][]byte{
+		"projects.tf":                  projectsFile.Bytes(),
+		"datalakes.tf":                 datalakesFile.Bytes(),
+		"source_apps.tf":               sourceAppsFile.Bytes(),
+		"source_app_datalake_links.tf": linksFile.Bytes(),
+	}
+}
+
+// appendExportedResource appends the import block and the resource block of
+// an object to body and returns the body of the resource block.
+func appendExportedResource(body *hclwrite.Body, names *resourceNames, id, name string) *hclwrite.Body {
+	resourceName := names.add(id, name)
+
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+
+	importBlock := body.AppendNewBlock("import", nil)
+	importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: names.resourceType},
+		hcl.TraverseAttr{Name: resourceName},
+	})
+	importBlock.Body().SetAttributeValue("id", cty.StringVal(id))
+	body.AppendNewline()
+
+	return body.AppendNewBlock("resource", []string{names.resourceType, resourceName}).Body()
+}
+
+// setReference sets an attribute holding the ID of another object. Objects
+// exported in the same run are referred to by their resource, others by ID.
+func setReference(body *hclwrite.Body, attribute string, names *resourceNames, id string) {
+	resourceName, ok := names.byID[id]
+	if !ok {
+		body.SetAttributeValue(attribute, cty.StringVal(id))
+		return
+	}
+
+	body.SetAttributeTraversal(attribute, hcl.Traversal{
+		hcl.TraverseRoot{Name: names.resourceType},
+		hcl.TraverseAttr{Name: resourceName},
+		hcl.TraverseAttr{Name: "id"},
+	})
+}
+
+// resourceNames assigns unique Terraform resource names to the objects of a
+// resource type.
+type resourceNames struct {
+	resourceType string
+	byID         map[string]string
+	used         map[string]bool
+}
+
+func newResourceNames(resourceType string) *resourceNames {
+	return &resourceNames{
+		resourceType: resourceType,
+		byID:         make(map[string]string),
+		used:         make(map[string]bool),
+	}
+}
+
+// invalidNameChars matches runs of characters not allowed in resource names.
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// add assigns a resource name derived from the object name to the object with
+// the given ID. Names taken by other objects get a numeric suffix.
+func (n *resourceNames) add(id, name string) string {
+	base := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if base == "" {
+		base = "unnamed"
+	}
+	if base[0] >= '0' && base[0] <= '9' {
+		base = "_" + base
+	}
+
+	resourceName := base
+	for i := 2; n.used[resourceName]; i++ {
+		resourceName = base + "_" + strconv.Itoa(i)
+	}
+
+	n.used[resourceName] = true
+	n.byID[id] = resourceName
+	return resourceName
+}
+
+// nameOf returns the resource name of the object with the given ID, or the ID
+// itself when the object is not exported.
+func (n *resourceNames) nameOf(id string) string {
+	if resourceName, ok := n.byID[id]; ok {
+		return resourceName
+	}
+	return id
+}
+
+// writeNewFile writes data to a file that must not exist yet, so that an
+// export never overwrites existing configuration.
+func writeNewFile(name string, data []byte) error {
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", name, err)
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+	return f.Close()
+}
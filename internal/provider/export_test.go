@@ -0,0 +1,161 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+func TestExport(t *testing.T) {
+	const (
+		projectID   = "11111111-1111-1111-1111-111111111111"
+		datalakeID  = "22222222-2222-2222-2222-222222222222"
+		sourceAppID = "33333333-3333-3333-3333-333333333333"
+		linkID      = "44444444-4444-4444-4444-444444444444"
+		otherID     = "55555555-5555-5555-5555-555555555555"
+	)
+
+	f := newFakeAPI(t)
+	f.add(
+		traceforce.HostingEnvironment{ID: projectID, Name: "Production", Type: traceforce.HostingEnvironmentTypeCustomerManaged, CloudProvider: traceforce.CloudProviderGCP, NativeID: "my-gcp-project"},
+		traceforce.Datalake{ID: datalakeID, HostingEnvironmentID: projectID, Name: "analytics", Type: traceforce.DatalakeTypeBigQuery, EnvironmentNativeID: "my-gcp-project", Region: "us-central1"},
+		traceforce.SourceApp{ID: sourceAppID, HostingEnvironmentID: projectID, Name: "salesforce-prod", Type: traceforce.SourceAppTypeSalesforce},
+		traceforce.SourceApp{ID: otherID, HostingEnvironmentID: projectID, Name: "salesforce prod", Type: traceforce.SourceAppTypeSalesforce},
+		traceforce.SourceAppDatalakeLink{ID: linkID, SourceAppID: sourceAppID, DatalakeID: datalakeID},
+	)
+
+	t.Setenv("TRACEFORCE_API_KEY", fakeAPIKey)
+	t.Setenv("TRACEFORCE_ENDPOINT", f.server.URL)
+
+	dir := t.TempDir()
+	if err := Export(t.Context(), dir); err != nil {
+		t.Fatalf("unexpected export error: %v", err)
+	}
+
+	expected := map[string]string{
+		"projects.tf": `import {
+  to = traceforce_project.production
+  id = "` + projectID + `"
+}
+
+resource "traceforce_project" "production" {
+  name           = "Production"
+  type           = "customer_managed"
+  cloud_provider = "gcp"
+  native_id      = "my-gcp-project"
+}
+`,
+		"datalakes.tf": `import {
+  to = traceforce_datalake.analytics
+  id = "` + datalakeID + `"
+}
+
+resource "traceforce_datalake" "analytics" {
+  project_id            = traceforce_project.production.id
+  type                  = "bigquery"
+  name                  = "analytics"
+  environment_native_id = "my-gcp-project"
+  region                = "us-central1"
+}
+`,
+		"source_apps.tf": `import {
+  to = traceforce_source_app.salesforce_prod
+  id = "` + sourceAppID + `"
+}
+
+resource "traceforce_source_app" "salesforce_prod" {
+  hosting_environment_id = traceforce_project.production.id
+  type                   = "salesforce"
+  name                   = "salesforce-prod"
+}
+
+import {
+  to = traceforce_source_app.salesforce_prod_2
+  id = "` + otherID + `"
+}
+
+resource "traceforce_source_app" "salesforce_prod_2" {
+  hosting_environment_id = traceforce_project.production.id
+  type                   = "salesforce"
+  name                   = "salesforce prod"
+}
+`,
+		"source_app_datalake_links.tf": `import {
+  to = traceforce_source_app_datalake_link.salesforce_prod_to_analytics
+  id = "` + linkID + `"
+}
+
+resource "traceforce_source_app_datalake_link" "salesforce_prod_to_analytics" {
+  source_app_id = traceforce_source_app.salesforce_prod.id
+  datalake_id   = traceforce_datalake.analytics.id
+}
+`,
+	}
+
+	for name, want := range expected {
+		got, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("unexpected read error: %v", err)
+		}
+		if string(got) != want {
+			t.Errorf("unexpected %s:\ngot:\n%s\nwant:\n%s", name, got, want)
+		}
+	}
+
+	// A second export must not overwrite the generated files.
+	if err := Export(t.Context(), dir); err == nil {
+		t.Error("expected export into a directory with generated files to fail")
+	}
+}
+
+func TestExportRequiresAPIKey(t *testing.T) {
+	t.Setenv("TRACEFORCE_API_KEY", "")
+
+	if err := Export(t.Context(), t.TempDir()); err == nil {
+		t.Error("expected export without an API key to fail")
+	}
+}
+
+func TestExportWritesNothingIfAFileExists(t *testing.T) {
+	f := newFakeAPI(t)
+	t.Setenv("TRACEFORCE_API_KEY", fakeAPIKey)
+	t.Setenv("TRACEFORCE_ENDPOINT", f.server.URL)
+
+	dir := t.TempDir()
+	existing := filepath.Join(dir, exportFileNames[len(exportFileNames)-1])
+	if err := os.WriteFile(existing, []byte("# existing\n"), 0o644); err != nil {
+		t.Fatalf("unexpected write error: %v", err)
+	}
+
+	if err := Export(t.Context(), dir); err == nil {
+		t.Fatal("expected export into a directory with an existing file to fail")
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("unexpected read error: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected only the existing file to be left, got %d files", len(entries))
+	}
+	if got, _ := os.ReadFile(existing); string(got) != "# existing\n" {
+		t.Errorf("expected the existing file to be kept, got %q", got)
+	}
+}
+
+func TestExportRetryEnvironment(t *testing.T) {
+	f := newFakeAPI(t)
+	t.Setenv("TRACEFORCE_API_KEY", fakeAPIKey)
+	t.Setenv("TRACEFORCE_ENDPOINT", f.server.URL)
+	t.Setenv("TRACEFORCE_MAX_RETRIES", "three")
+
+	if err := Export(t.Context(), t.TempDir()); err == nil || !strings.Contains(err.Error(), "TRACEFORCE_MAX_RETRIES") {
+		t.Errorf("expected an invalid TRACEFORCE_MAX_RETRIES error, got %v", err)
+	}
+}
@@ -0,0 +1,905 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+// fakeAPIKey is the API key accepted by the fake Traceforce API.
+const fakeAPIKey = "fake-api-key"
+
+// fakeAPI is an in-memory implementation of the Traceforce API endpoints used
+// by the provider. It lets resources and data sources be tested without
+// credentials or network access, either directly or with resource.UnitTest.
+type fakeAPI struct {
+	server *httptest.Server
+
+	mu                  sync.Mutex
+	hostingEnvironments []traceforce.HostingEnvironment
+	datalakes           []traceforce.Datalake
+	sourceApps          []traceforce.SourceApp
+	links               []traceforce.SourceAppDatalakeLink
+	postConnections     map[string]json.RawMessage
+	failures            []*fakeFailure
+
+	// transitions holds the statuses reported by the objects with a given
+	// name, one per response. See transition.
+	transitions map[string][]string
+}
+
+// fakeFailure makes the fake API answer matching requests with an error.
+type fakeFailure struct {
+	method    string
+	path      string
+	status    int
+	remaining int
+}
+
+// newFakeAPI starts a fake Traceforce API that is shut down when the test
+// ends.
+func newFakeAPI(t *testing.T) *fakeAPI {
+	t.Helper()
+
+	f := &fakeAPI{
+		postConnections: make(map[string]json.RawMessage),
+		transitions:     make(map[string][]string),
+	}
+	f.server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+// providerConfig returns a provider configuration pointing at the fake API.
+func (f *fakeAPI) providerConfig() string {
+	return fmt.Sprintf(`
+provider "traceforce" {
+  api_key     = %q
+  endpoint    = %q
+  max_retries = 0
+}
+`, fakeAPIKey, f.server.URL)
+}
+
+// client returns an API client for the fake API, for tests to set up or
+// inspect objects outside of Terraform.
+func (f *fakeAPI) client() *apiClient {
+	return mustAPIClient(fakeAPIKey, f.server.URL, apiClientOptions{})
+}
+
+// mustAPIClient is like newAPIClient but panics if the client cannot be
+// created.
+func mustAPIClient(apiKey, endpoint string, options apiClientOptions) *apiClient {
+	client, err := newAPIClient(apiKey, endpoint, options)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// readDataSource configures d with a client for the fake API and reads it with
+// the given configuration model. It calls the data source directly, so it
+// does not need the Terraform CLI.
+func (f *fakeAPI) readDataSource(t *testing.T, d datasource.DataSource, config any) *datasource.ReadResponse {
+	t.Helper()
+	ctx := t.Context()
+
+	if d, ok := d.(datasource.DataSourceWithConfigure); ok {
+		configureResp := &datasource.ConfigureResponse{}
+		d.Configure(ctx, datasource.ConfigureRequest{ProviderData: f.client()}, configureResp)
+		if configureResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+		}
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	// Build the configuration through a state, which supports setting a whole
+	// model.
+	configState := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := configState.Set(ctx, config); diags.HasError() {
+		t.Fatalf("unexpected config diagnostics: %v", diags)
+	}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw}}, resp)
+	return resp
+}
+
+// lookupTestCase is a test case for a data source looking up a single object.
+type lookupTestCase[M any] struct {
+	config      M
+	expectedID  string
+	expectError string
+}
+
+// testLookup reads the data source returned by newDataSource from the fake API
+// with the configuration of each test case, and checks that it finds the
+// expected object or fails with the expected error summary. check verifies
+// the state of the object found.
+func testLookup[M any](t *testing.T, f *fakeAPI, newDataSource func() datasource.DataSource, testCases map[string]lookupTestCase[M], check func(t *testing.T, state M, expectedID string)) {
+	t.Helper()
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp := f.readDataSource(t, newDataSource(), &testCase.config)
+
+			if testCase.expectError != "" {
+				errs := resp.Diagnostics.Errors()
+				if len(errs) != 1 || errs[0].Summary() != testCase.expectError {
+					t.Fatalf("expected error %q, got: %v", testCase.expectError, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+
+			var state M
+			if diags := resp.State.Get(t.Context(), &state); diags.HasError() {
+				t.Fatalf("unexpected state diagnostics: %v", diags)
+			}
+			check(t, state, testCase.expectedID)
+		})
+	}
+}
+
+// configureResource configures r with a client for the fake API and returns
+// its schema.
+func (f *fakeAPI) configureResource(t *testing.T, r fwresource.Resource) schema.Schema {
+	t.Helper()
+	ctx := t.Context()
+
+	if r, ok := r.(fwresource.ResourceWithConfigure); ok {
+		configureResp := &fwresource.ConfigureResponse{}
+		r.Configure(ctx, fwresource.ConfigureRequest{ProviderData: f.client()}, configureResp)
+		if configureResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+		}
+	}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	return schemaResp.Schema
+}
+
+// resourceState returns the state of a resource with the given schema holding
+// model.
+func resourceState(t *testing.T, s schema.Schema, model any) tfsdk.State {
+	t.Helper()
+
+	state := tfsdk.State{Schema: s}
+	if diags := state.Set(t.Context(), model); diags.HasError() {
+		t.Fatalf("unexpected state diagnostics: %v", diags)
+	}
+	return state
+}
+
+// createResource configures r with a client for the fake API and creates it
+// with the given plan model, which is also used as its configuration. Like
+// readDataSource, it calls the resource directly, so it does not need the
+// Terraform CLI.
+func (f *fakeAPI) createResource(t *testing.T, r fwresource.Resource, plan any) *fwresource.CreateResponse {
+	t.Helper()
+	s := f.configureResource(t, r)
+	planState := resourceState(t, s, plan)
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: s}}
+	r.Create(t.Context(), fwresource.CreateRequest{
+		Config: tfsdk.Config{Schema: s, Raw: planState.Raw},
+		Plan:   tfsdk.Plan{Schema: s, Raw: planState.Raw},
+	}, resp)
+	return resp
+}
+
+// readResource configures r with a client for the fake API and refreshes the
+// given state model.
+func (f *fakeAPI) readResource(t *testing.T, r fwresource.Resource, state any) *fwresource.ReadResponse {
+	t.Helper()
+	s := f.configureResource(t, r)
+	prior := resourceState(t, s, state)
+
+	resp := &fwresource.ReadResponse{State: prior}
+	r.Read(t.Context(), fwresource.ReadRequest{State: prior}, resp)
+	return resp
+}
+
+// updateResource configures r with a client for the fake API and updates the
+// given state model to the given plan model, which is also used as its
+// configuration.
+func (f *fakeAPI) updateResource(t *testing.T, r fwresource.Resource, state, plan any) *fwresource.UpdateResponse {
+	t.Helper()
+	s := f.configureResource(t, r)
+	prior := resourceState(t, s, state)
+	planState := resourceState(t, s, plan)
+
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: s, Raw: planState.Raw}}
+	r.Update(t.Context(), fwresource.UpdateRequest{
+		Config: tfsdk.Config{Schema: s, Raw: planState.Raw},
+		Plan:   tfsdk.Plan{Schema: s, Raw: planState.Raw},
+		State:  prior,
+	}, resp)
+	return resp
+}
+
+// deleteResource configures r with a client for the fake API and deletes the
+// given state model.
+func (f *fakeAPI) deleteResource(t *testing.T, r fwresource.Resource, state any) *fwresource.DeleteResponse {
+	t.Helper()
+	s := f.configureResource(t, r)
+	prior := resourceState(t, s, state)
+
+	resp := &fwresource.DeleteResponse{State: prior}
+	r.Delete(t.Context(), fwresource.DeleteRequest{State: prior}, resp)
+	return resp
+}
+
+// lifecycleTestCase describes the lifecycle of a resource tested by
+// testResourceLifecycle.
+type lifecycleTestCase[M any] struct {
+	// create is the plan the resource is created with.
+	create M
+
+	// update returns the plan the resource is updated with from its state, or
+	// is nil for resources replaced on every change.
+	update func(state M) M
+
+	// check verifies the state of the resource after the create or update
+	// step.
+	check func(t *testing.T, step string, state M)
+}
+
+// testResourceLifecycle creates, refreshes, updates and deletes a resource of
+// the type returned by newResource through the fake API. It calls the
+// resource directly, so unlike the TestUnit tests it runs without the
+// Terraform CLI.
+func testResourceLifecycle[M any](t *testing.T, f *fakeAPI, newResource func() fwresource.Resource, testCase lifecycleTestCase[M]) {
+	t.Helper()
+	ctx := t.Context()
+
+	// refresh checks that reading the resource does not change its state.
+	refresh := func(step string, state M) {
+		t.Helper()
+
+		resp := f.readResource(t, newResource(), &state)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected read diagnostics: %v", step, resp.Diagnostics)
+		}
+
+		var refreshed M
+		if diags := resp.State.Get(ctx, &refreshed); diags.HasError() {
+			t.Fatalf("%s: unexpected state diagnostics: %v", step, diags)
+		}
+		if !reflect.DeepEqual(refreshed, state) {
+			t.Errorf("%s: expected refresh to keep the state\nexpected: %+v\ngot:      %+v", step, state, refreshed)
+		}
+	}
+
+	var state M
+	createResp := f.createResource(t, newResource(), &testCase.create)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", createResp.Diagnostics)
+	}
+	if diags := createResp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("unexpected state diagnostics: %v", diags)
+	}
+	testCase.check(t, "create", state)
+	refresh("create", state)
+
+	if testCase.update != nil {
+		plan := testCase.update(state)
+		updateResp := f.updateResource(t, newResource(), &state, &plan)
+		if updateResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected update diagnostics: %v", updateResp.Diagnostics)
+		}
+		if diags := updateResp.State.Get(ctx, &state); diags.HasError() {
+			t.Fatalf("unexpected state diagnostics: %v", diags)
+		}
+		testCase.check(t, "update", state)
+		refresh("update", state)
+	}
+
+	deleteResp := f.deleteResource(t, newResource(), &state)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected delete diagnostics: %v", deleteResp.Diagnostics)
+	}
+	readResp := f.readResource(t, newResource(), &state)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics after delete: %v", readResp.Diagnostics)
+	}
+	if !readResp.State.Raw.IsNull() {
+		t.Error("expected the deleted resource to be removed from state")
+	}
+}
+
+// fakeAccount holds the IDs of the objects created by seed.
+type fakeAccount struct {
+	projectID   string
+	datalakeID  string
+	sourceAppID string
+	linkID      string
+}
+
+// seed creates a project with a datalake and a source app linked to it.
+func (f *fakeAPI) seed(t *testing.T, projectName string) fakeAccount {
+	t.Helper()
+	client := f.client()
+
+	project, err := client.CreateHostingEnvironment(t.Context(), traceforce.CreateHostingEnvironmentRequest{
+		Name:          projectName,
+		Type:          traceforce.HostingEnvironmentTypeCustomerManaged,
+		CloudProvider: traceforce.CloudProviderGCP,
+		NativeID:      projectName,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %v", err)
+	}
+	datalake, err := client.CreateDatalake(t.Context(), traceforce.CreateDatalakeRequest{
+		HostingEnvironmentID: project.ID,
+		Type:                 traceforce.DatalakeTypeBigQuery,
+		Name:                 projectName + "-warehouse",
+		EnvironmentNativeID:  projectName,
+		Region:               "us-central1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating datalake: %v", err)
+	}
+	sourceApp, err := client.CreateSourceApp(t.Context(), traceforce.CreateSourceAppRequest{
+		HostingEnvironmentID: project.ID,
+		Type:                 traceforce.SourceAppTypeSalesforce,
+		Name:                 projectName + "-salesforce",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating source app: %v", err)
+	}
+	link, err := client.CreateSourceAppDatalakeLink(t.Context(), traceforce.CreateSourceAppDatalakeLinkRequest{
+		SourceAppID: sourceApp.ID,
+		DatalakeID:  datalake.ID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating link: %v", err)
+	}
+
+	return fakeAccount{
+		projectID:   project.ID,
+		datalakeID:  datalake.ID,
+		sourceAppID: sourceApp.ID,
+		linkID:      link.ID,
+	}
+}
+
+// add stores objects in the fake API as they are, bypassing the checks of its
+// endpoints. It lets tests set up objects the API would not create, such as
+// objects sharing a name.
+func (f *fakeAPI) add(objects ...any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, object := range objects {
+		switch object := object.(type) {
+		case traceforce.HostingEnvironment:
+			f.hostingEnvironments = append(f.hostingEnvironments, object)
+		case traceforce.Datalake:
+			f.datalakes = append(f.datalakes, object)
+		case traceforce.SourceApp:
+			f.sourceApps = append(f.sourceApps, object)
+		case traceforce.SourceAppDatalakeLink:
+			f.links = append(f.links, object)
+		default:
+			panic(fmt.Sprintf("fake API cannot store %T", object))
+		}
+	}
+}
+
+// transition makes the hosting environment or datalake with the given name
+// report the given statuses, one per response, starting with the response
+// creating it. It keeps the last status once they are used up.
+func (f *fakeAPI) transition(name string, statuses ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.transitions[name] = statuses
+}
+
+// nextStatus returns the next status reported by the object with the given
+// name, if any.
+func (f *fakeAPI) nextStatus(name string) (string, bool) {
+	statuses := f.transitions[name]
+	if len(statuses) == 0 {
+		return "", false
+	}
+	f.transitions[name] = statuses[1:]
+	return statuses[0], true
+}
+
+// checkDestroyed is a resource.TestCheckFunc verifying that no objects are
+// left in the fake API.
+func (f *fakeAPI) checkDestroyed(_ *terraform.State) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	remaining := len(f.hostingEnvironments) + len(f.datalakes) + len(f.sourceApps) + len(f.links) + len(f.postConnections)
+	if remaining > 0 {
+		return fmt.Errorf("expected all objects to be destroyed, %d remain", remaining)
+	}
+	return nil
+}
+
+// fail makes the next count requests with the given method whose path starts
+// with path fail with the given status code.
+func (f *fakeAPI) fail(method, path string, status, count int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.failures = append(f.failures, &fakeFailure{method: method, path: path, status: status, remaining: count})
+}
+
+// injectedFailure returns the status code of the failure injected for the
+// request, if any.
+func (f *fakeAPI) injectedFailure(r *http.Request) (int, bool) {
+	for _, failure := range f.failures {
+		if failure.remaining > 0 && failure.method == r.Method && strings.HasPrefix(r.URL.Path, failure.path) {
+			failure.remaining--
+			return failure.status, true
+		}
+	}
+	return 0, false
+}
+
+func (f *fakeAPI) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set(requestIDHeader, "req-"+uuid.New().String())
+	if r.Header.Get("Authorization") != "Bearer "+fakeAPIKey {
+		writeFakeError(w, http.StatusUnauthorized, "invalid API key")
+		return
+	}
+	if status, ok := f.injectedFailure(r); ok {
+		writeFakeError(w, status, "injected failure")
+		return
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch segments[0] {
+	case "hosting-environments":
+		f.serveHostingEnvironments(w, r, segments[1:])
+	case "datalakes":
+		f.serveDatalakes(w, r, segments[1:])
+	case "source-apps":
+		f.serveSourceApps(w, r, segments[1:])
+	case "source-apps-datalakes":
+		f.serveLinks(w, r, segments[1:])
+	default:
+		writeFakeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (f *fakeAPI) serveHostingEnvironments(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		writeFakeJSON(w, http.StatusOK, f.hostingEnvironments)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		var req traceforce.CreateHostingEnvironmentRequest
+		if !decodeFakeRequest(w, r, &req) {
+			return
+		}
+		for _, env := range f.hostingEnvironments {
+			if env.Name == req.Name {
+				writeFakeError(w, http.StatusConflict, fmt.Sprintf("hosting environment %q already exists", req.Name))
+				return
+			}
+		}
+
+		now := fakeNow()
+		env := traceforce.HostingEnvironment{
+			ID:            uuid.New().String(),
+			Name:          req.Name,
+			Type:          req.Type,
+			CloudProvider: req.CloudProvider,
+			NativeID:      req.NativeID,
+			Status:        traceforce.HostingEnvironmentStatusPending,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+		if status, ok := f.nextStatus(env.Name); ok {
+			env.Status = traceforce.HostingEnvironmentStatus(status)
+		}
+		f.hostingEnvironments = append(f.hostingEnvironments, env)
+		writeFakeJSON(w, http.StatusCreated, env)
+	case len(segments) == 1:
+		i := indexByID(f.hostingEnvironments, segments[0], func(env traceforce.HostingEnvironment) string { return env.ID })
+		if i < 0 {
+			writeFakeError(w, http.StatusNotFound, "hosting environment not found")
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			if status, ok := f.nextStatus(f.hostingEnvironments[i].Name); ok {
+				f.hostingEnvironments[i].Status = traceforce.HostingEnvironmentStatus(status)
+			}
+			writeFakeJSON(w, http.StatusOK, f.hostingEnvironments[i])
+		case http.MethodPatch:
+			var req traceforce.UpdateHostingEnvironmentRequest
+			if !decodeFakeRequest(w, r, &req) {
+				return
+			}
+			if req.Name != nil {
+				f.hostingEnvironments[i].Name = *req.Name
+			}
+			f.hostingEnvironments[i].UpdatedAt = fakeNow()
+			writeFakeJSON(w, http.StatusOK, f.hostingEnvironments[i])
+		case http.MethodDelete:
+			delete(f.postConnections, segments[0])
+			f.hostingEnvironments = append(f.hostingEnvironments[:i], f.hostingEnvironments[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeFakeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+	case len(segments) == 2 && segments[1] == "post-connection":
+		f.servePostConnection(w, r, segments[0])
+	default:
+		writeFakeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (f *fakeAPI) servePostConnection(w http.ResponseWriter, r *http.Request, hostingEnvironmentID string) {
+	i := indexByID(f.hostingEnvironments, hostingEnvironmentID, func(env traceforce.HostingEnvironment) string { return env.ID })
+	if i < 0 {
+		writeFakeError(w, http.StatusNotFound, "hosting environment not found")
+		return
+	}
+
+	// Like the real API, the fake only supports applying a post-connection
+	// and does not return it.
+	if r.Method != http.MethodPost {
+		writeFakeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req json.RawMessage
+	if !decodeFakeRequest(w, r, &req) {
+		return
+	}
+	f.postConnections[hostingEnvironmentID] = req
+	f.hostingEnvironments[i].Status = traceforce.HostingEnvironmentStatusConnected
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (f *fakeAPI) serveDatalakes(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		datalakes := []traceforce.Datalake{}
+		for _, datalake := range f.datalakes {
+			if id := r.URL.Query().Get("hosting_environment_id"); id == "" || datalake.HostingEnvironmentID == id {
+				datalakes = append(datalakes, datalake)
+			}
+		}
+		writeFakeJSON(w, http.StatusOK, datalakes)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		var req traceforce.CreateDatalakeRequest
+		if !decodeFakeRequest(w, r, &req) {
+			return
+		}
+		if indexByID(f.hostingEnvironments, req.HostingEnvironmentID, func(env traceforce.HostingEnvironment) string { return env.ID }) < 0 {
+			writeFakeError(w, http.StatusUnprocessableEntity, "hosting environment not found")
+			return
+		}
+		for _, datalake := range f.datalakes {
+			if datalake.HostingEnvironmentID == req.HostingEnvironmentID && datalake.Name == req.Name {
+				writeFakeError(w, http.StatusConflict, fmt.Sprintf("datalake %q already exists", req.Name))
+				return
+			}
+		}
+
+		// The fake deploys datalakes instantly.
+		now := fakeNow()
+		datalake := traceforce.Datalake{
+			ID:                   uuid.New().String(),
+			HostingEnvironmentID: req.HostingEnvironmentID,
+			Type:                 req.Type,
+			Name:                 req.Name,
+			Status:               traceforce.DatalakeStatusReady,
+			EnvironmentNativeID:  req.EnvironmentNativeID,
+			Region:               req.Region,
+			CreatedAt:            now,
+			UpdatedAt:            now,
+		}
+		if status, ok := f.nextStatus(datalake.Name); ok {
+			datalake.Status = traceforce.DatalakeStatus(status)
+		}
+		f.datalakes = append(f.datalakes, datalake)
+		writeFakeJSON(w, http.StatusCreated, datalake)
+	case len(segments) == 1:
+		i := indexByID(f.datalakes, segments[0], func(datalake traceforce.Datalake) string { return datalake.ID })
+		if i < 0 {
+			writeFakeError(w, http.StatusNotFound, "datalake not found")
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			if status, ok := f.nextStatus(f.datalakes[i].Name); ok {
+				f.datalakes[i].Status = traceforce.DatalakeStatus(status)
+			}
+			writeFakeJSON(w, http.StatusOK, f.datalakes[i])
+		case http.MethodPatch:
+			var req traceforce.UpdateDatalakeRequest
+			if !decodeFakeRequest(w, r, &req) {
+				return
+			}
+			if req.Name != nil {
+				f.datalakes[i].Name = *req.Name
+			}
+			f.datalakes[i].UpdatedAt = fakeNow()
+			writeFakeJSON(w, http.StatusOK, f.datalakes[i])
+		case http.MethodDelete:
+			f.datalakes = append(f.datalakes[:i], f.datalakes[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeFakeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+	default:
+		writeFakeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (f *fakeAPI) serveSourceApps(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		sourceApps := []traceforce.SourceApp{}
+		for _, sourceApp := range f.sourceApps {
+			if id := r.URL.Query().Get("hosting_environment_id"); id == "" || sourceApp.HostingEnvironmentID == id {
+				sourceApps = append(sourceApps, sourceApp)
+			}
+		}
+		writeFakeJSON(w, http.StatusOK, sourceApps)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		var req traceforce.CreateSourceAppRequest
+		if !decodeFakeRequest(w, r, &req) {
+			return
+		}
+		if indexByID(f.hostingEnvironments, req.HostingEnvironmentID, func(env traceforce.HostingEnvironment) string { return env.ID }) < 0 {
+			writeFakeError(w, http.StatusUnprocessableEntity, "hosting environment not found")
+			return
+		}
+		for _, sourceApp := range f.sourceApps {
+			if sourceApp.HostingEnvironmentID == req.HostingEnvironmentID && sourceApp.Name == req.Name {
+				writeFakeError(w, http.StatusConflict, fmt.Sprintf("source app %q already exists", req.Name))
+				return
+			}
+		}
+
+		now := fakeNow()
+		sourceApp := traceforce.SourceApp{
+			ID:                   uuid.New().String(),
+			HostingEnvironmentID: req.HostingEnvironmentID,
+			Type:                 req.Type,
+			Name:                 req.Name,
+			Status:               traceforce.SourceAppStatusPending,
+			CreatedAt:            now,
+			UpdatedAt:            now,
+		}
+		f.sourceApps = append(f.sourceApps, sourceApp)
+		writeFakeJSON(w, http.StatusCreated, sourceApp)
+	case len(segments) == 1:
+		i := indexByID(f.sourceApps, segments[0], func(sourceApp traceforce.SourceApp) string { return sourceApp.ID })
+		if i < 0 {
+			writeFakeError(w, http.StatusNotFound, "source app not found")
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			writeFakeJSON(w, http.StatusOK, f.sourceApps[i])
+		case http.MethodPatch:
+			var req traceforce.UpdateSourceAppRequest
+			if !decodeFakeRequest(w, r, &req) {
+				return
+			}
+			if req.Name != nil {
+				f.sourceApps[i].Name = *req.Name
+			}
+			f.sourceApps[i].UpdatedAt = fakeNow()
+			writeFakeJSON(w, http.StatusOK, f.sourceApps[i])
+		case http.MethodDelete:
+			f.sourceApps = append(f.sourceApps[:i], f.sourceApps[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeFakeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+	default:
+		writeFakeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (f *fakeAPI) serveLinks(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		query := r.URL.Query()
+		links := []traceforce.SourceAppDatalakeLink{}
+		for _, link := range f.links {
+			if id := query.Get("source_app_id"); id != "" && link.SourceAppID != id {
+				continue
+			}
+			if id := query.Get("datalake_id"); id != "" && link.DatalakeID != id {
+				continue
+			}
+			links = append(links, link)
+		}
+		writeFakeJSON(w, http.StatusOK, links)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		var req traceforce.CreateSourceAppDatalakeLinkRequest
+		if !decodeFakeRequest(w, r, &req) {
+			return
+		}
+		sourceApp := indexByID(f.sourceApps, req.SourceAppID, func(sourceApp traceforce.SourceApp) string { return sourceApp.ID })
+		if sourceApp < 0 {
+			writeFakeError(w, http.StatusUnprocessableEntity, "source app not found")
+			return
+		}
+		if indexByID(f.datalakes, req.DatalakeID, func(datalake traceforce.Datalake) string { return datalake.ID }) < 0 {
+			writeFakeError(w, http.StatusUnprocessableEntity, "datalake not found")
+			return
+		}
+		for _, link := range f.links {
+			if link.SourceAppID == req.SourceAppID && link.DatalakeID == req.DatalakeID {
+				writeFakeError(w, http.StatusConflict, "source app is already linked to the datalake")
+				return
+			}
+		}
+
+		now := fakeNow()
+		link := traceforce.SourceAppDatalakeLink{
+			ID:                   uuid.New().String(),
+			SourceAppID:          req.SourceAppID,
+			DatalakeID:           req.DatalakeID,
+			HostingEnvironmentID: f.sourceApps[sourceApp].HostingEnvironmentID,
+			CreatedAt:            now,
+			UpdatedAt:            now,
+		}
+		f.links = append(f.links, link)
+		writeFakeJSON(w, http.StatusCreated, link)
+	case len(segments) == 1:
+		i := indexByID(f.links, segments[0], func(link traceforce.SourceAppDatalakeLink) string { return link.ID })
+		if i < 0 {
+			writeFakeError(w, http.StatusNotFound, "source app datalake link not found")
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			writeFakeJSON(w, http.StatusOK, f.links[i])
+		case http.MethodDelete:
+			f.links = append(f.links[:i], f.links[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeFakeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+	default:
+		writeFakeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+// indexByID returns the index of the object with the given ID, or -1.
+func indexByID[T any](objects []T, id string, idOf func(T) string) int {
+	for i, object := range objects {
+		if idOf(object) == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// fakeNow returns the current time at the precision stored in state.
+func fakeNow() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+func decodeFakeRequest(w http.ResponseWriter, r *http.Request, req any) bool {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		writeFakeError(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+	return true
+}
+
+func writeFakeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeFakeError(w http.ResponseWriter, status int, message string) {
+	writeFakeJSON(w, status, map[string]string{"error": message})
+}
+
+// testUnitPreCheck skips tests running Terraform against the fake API when no
+// Terraform CLI is available, except in CI, which provides it. Unlike
+// acceptance tests, they need no credentials.
+func testUnitPreCheck(t *testing.T) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" {
+		return
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		const message = "Terraform CLI must be installed or TF_ACC_TERRAFORM_PATH set to run tests against the fake API"
+		if os.Getenv("CI") != "" {
+			t.Fatal(message)
+		}
+		t.Skip(message)
+	}
+}
+
+func TestFakeAPI(t *testing.T) {
+	f := newFakeAPI(t)
+	client := f.client()
+	account := f.seed(t, "production")
+	f.seed(t, "staging")
+
+	datalakes, err := client.GetDatalakesByHostingEnvironment(t.Context(), account.projectID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(datalakes) != 1 || datalakes[0].ID != account.datalakeID {
+		t.Errorf("expected datalake %s, got %v", account.datalakeID, datalakes)
+	}
+
+	links, err := client.GetSourceAppDatalakeLinksBySourceApp(t.Context(), account.sourceAppID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(links) != 1 || links[0].ID != account.linkID || links[0].HostingEnvironmentID != account.projectID {
+		t.Errorf("expected link %s in project %s, got %v", account.linkID, account.projectID, links)
+	}
+
+	if _, err := client.CreateHostingEnvironment(t.Context(), traceforce.CreateHostingEnvironmentRequest{Name: "production"}); !isStatus(err, http.StatusConflict) {
+		t.Errorf("expected conflict creating a duplicate project, got %v", err)
+	}
+
+	f.fail(http.MethodGet, "/datalakes/", http.StatusInternalServerError, 1)
+	if _, err := client.GetDatalake(t.Context(), account.datalakeID); !isStatus(err, http.StatusInternalServerError) {
+		t.Errorf("expected injected failure, got %v", err)
+	}
+	if _, err := client.GetDatalake(t.Context(), account.datalakeID); err != nil {
+		t.Errorf("expected injected failure to be used up, got %v", err)
+	}
+
+	if err := client.DeleteSourceAppDatalakeLink(t.Context(), account.linkID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.GetSourceAppDatalakeLink(t.Context(), account.linkID); !isNotFound(err) {
+		t.Errorf("expected deleted link to be not found, got %v", err)
+	}
+
+	unauthorized := mustAPIClient("wrong-key", f.server.URL, apiClientOptions{})
+	if _, err := unauthorized.GetHostingEnvironments(t.Context()); !isStatus(err, http.StatusUnauthorized) {
+		t.Errorf("expected unauthorized, got %v", err)
+	}
+}
+
+// isStatus reports whether err is an API error with the given status code.
+func isStatus(err error, status int) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.StatusCode == status
+}
@@ -0,0 +1,157 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+// filterModel maps the filter schema data shared by the plural data sources.
+type filterModel struct {
+	Status        types.String `tfsdk:"status"`
+	Type          types.String `tfsdk:"type"`
+	CloudProvider types.String `tfsdk:"cloud_provider"`
+	NameRegex     types.String `tfsdk:"name_regex"`
+	CreatedAfter  types.String `tfsdk:"created_after"`
+}
+
+// filterBlock returns the filter block of a plural data source listing objects
+// of the given kind, which can have the given statuses and types.
+func filterBlock(kind string, statuses, objectTypes []string) schema.SingleNestedBlock {
+	cloudProviderDescription := fmt.Sprintf("Only return %ss in projects on this cloud provider.", kind)
+	if kind == "project" {
+		cloudProviderDescription = "Only return projects on this cloud provider."
+	}
+
+	return schema.SingleNestedBlock{
+		Description: fmt.Sprintf("Only return %ss matching all of the given conditions.", kind),
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				Description: fmt.Sprintf("Only return %ss with this status.", kind),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(statuses...),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: fmt.Sprintf("Only return %ss of this type.", kind),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(objectTypes...),
+				},
+			},
+			"cloud_provider": schema.StringAttribute{
+				Description: cloudProviderDescription,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(stringValues(traceforce.CloudProviderAWS, traceforce.CloudProviderGCP, traceforce.CloudProviderAzure)...),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: fmt.Sprintf("Only return %ss whose name matches this regular expression.", kind),
+				Optional:    true,
+			},
+			"created_after": schema.StringAttribute{
+				Description: fmt.Sprintf("Only return %ss created after this RFC 3339 timestamp, for example 2025-01-02T15:04:05Z.", kind),
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// objectFilter is a parsed filter. The zero value matches every object.
+type objectFilter struct {
+	status        string
+	objectType    string
+	cloudProvider string
+	nameRegex     *regexp.Regexp
+	createdAfter  time.Time
+}
+
+// parseFilter parses the filter of a plural data source. A nil model yields a
+// filter matching every object.
+func parseFilter(model *filterModel) (objectFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var filter objectFilter
+
+	if model == nil {
+		return filter, diags
+	}
+
+	filter.status = model.Status.ValueString()
+	filter.objectType = model.Type.ValueString()
+	filter.cloudProvider = model.CloudProvider.ValueString()
+
+	if !model.NameRegex.IsNull() {
+		nameRegex, err := regexp.Compile(model.NameRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("filter").AtName("name_regex"), "Invalid name regex", err.Error())
+		}
+		filter.nameRegex = nameRegex
+	}
+
+	if !model.CreatedAfter.IsNull() {
+		createdAfter, err := time.Parse(time.RFC3339, model.CreatedAfter.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("filter").AtName("created_after"), "Invalid created_after timestamp",
+				fmt.Sprintf("Expected an RFC 3339 timestamp, for example 2025-01-02T15:04:05Z: %s", err))
+		}
+		filter.createdAfter = createdAfter
+	}
+
+	return filter, diags
+}
+
+// byCloudProvider reports whether objects must be matched against the cloud
+// provider of their project, which the caller then has to look up.
+func (f objectFilter) byCloudProvider() bool {
+	return f.cloudProvider != ""
+}
+
+// matches reports whether an object with the given attributes passes the
+// filter. cloudProvider is the cloud provider of the object's project.
+func (f objectFilter) matches(name, status, objectType, cloudProvider string, createdAt time.Time) bool {
+	if f.status != "" && status != f.status {
+		return false
+	}
+	if f.objectType != "" && objectType != f.objectType {
+		return false
+	}
+	if f.cloudProvider != "" && cloudProvider != f.cloudProvider {
+		return false
+	}
+	if f.nameRegex != nil && !f.nameRegex.MatchString(name) {
+		return false
+	}
+	if !f.createdAfter.IsZero() && !createdAt.After(f.createdAfter) {
+		return false
+	}
+	return true
+}
+
+// projectCloudProviders returns the cloud provider of every project, keyed by
+// project ID, for filtering child objects by cloud provider.
+func projectCloudProviders(ctx context.Context, client *apiClient) (map[string]string, error) {
+	hostingEnvironments, err := client.GetHostingEnvironments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cloudProviders := make(map[string]string, len(hostingEnvironments))
+	for _, env := range hostingEnvironments {
+		cloudProviders[env.ID] = string(env.CloudProvider)
+	}
+	return cloudProviders, nil
+}
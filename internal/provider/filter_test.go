@@ -0,0 +1,129 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestObjectFilter(t *testing.T) {
+	createdAt := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		filter      *filterModel
+		expectMatch bool
+		expectError bool
+	}{
+		"no-filter": {
+			expectMatch: true,
+		},
+		"all-conditions-match": {
+			filter: &filterModel{
+				Status:        types.StringValue("ready"),
+				Type:          types.StringValue("bigquery"),
+				CloudProvider: types.StringValue("gcp"),
+				NameRegex:     types.StringValue("^prod-"),
+				CreatedAfter:  types.StringValue("2025-01-01T00:00:00Z"),
+			},
+			expectMatch: true,
+		},
+		"status-mismatch": {
+			filter:      &filterModel{Status: types.StringValue("failed")},
+			expectMatch: false,
+		},
+		"cloud-provider-mismatch": {
+			filter:      &filterModel{CloudProvider: types.StringValue("aws")},
+			expectMatch: false,
+		},
+		"name-regex-mismatch": {
+			filter:      &filterModel{NameRegex: types.StringValue("^staging-")},
+			expectMatch: false,
+		},
+		"created-before": {
+			filter:      &filterModel{CreatedAfter: types.StringValue("2025-06-01T00:00:00Z")},
+			expectMatch: false,
+		},
+		"invalid-name-regex": {
+			filter:      &filterModel{NameRegex: types.StringValue("prod-(")},
+			expectError: true,
+		},
+		"invalid-created-after": {
+			filter:      &filterModel{CreatedAfter: types.StringValue("2025-01-01")},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			filter, diags := parseFilter(testCase.filter)
+			if testCase.expectError != diags.HasError() {
+				t.Fatalf("expected error: %t, got diagnostics: %v", testCase.expectError, diags)
+			}
+			if testCase.expectError {
+				return
+			}
+
+			if got := filter.matches("prod-warehouse", "ready", "bigquery", "gcp", createdAt); got != testCase.expectMatch {
+				t.Errorf("expected match: %t, got %t", testCase.expectMatch, got)
+			}
+		})
+	}
+}
+
+func TestPluralDataSourcesEmptyResult(t *testing.T) {
+	noMatch := &filterModel{NameRegex: types.StringValue("^no-match$")}
+
+	testCases := map[string]struct {
+		dataSource datasource.DataSource
+		config     any
+		listPath   path.Path
+	}{
+		"projects": {
+			dataSource: NewProjectsDataSource(),
+			config:     &projectsDataSourceModel{Filter: noMatch},
+			listPath:   path.Root("projects"),
+		},
+		"datalakes": {
+			dataSource: NewDatalakesDataSource(),
+			config:     &datalakesDataSourceModel{Filter: noMatch},
+			listPath:   path.Root("datalakes"),
+		},
+		"source-apps": {
+			dataSource: NewSourceAppsDataSource(),
+			config:     &sourceAppsDataSourceModel{Filter: noMatch},
+			listPath:   path.Root("source_apps"),
+		},
+		"links": {
+			dataSource: NewSourceAppDatalakeLinksDataSource(),
+			config:     &sourceAppDatalakeLinksDataSourceModel{SourceAppID: types.StringValue(uuid.New().String())},
+			listPath:   path.Root("links"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			f := newFakeAPI(t)
+			f.seed(t, "production")
+
+			resp := f.readDataSource(t, testCase.dataSource, testCase.config)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+
+			var list types.List
+			if diags := resp.State.GetAttribute(t.Context(), testCase.listPath, &list); diags.HasError() {
+				t.Fatalf("unexpected state diagnostics: %v", diags)
+			}
+			if list.IsNull() || len(list.Elements()) != 0 {
+				t.Errorf("expected an empty list, got: %s", list)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &gcpWorkloadIdentityProviderNameFunction{}
+
+// NewGCPWorkloadIdentityProviderNameFunction creates a new
+// gcp_workload_identity_provider_name function.
+func NewGCPWorkloadIdentityProviderNameFunction() function.Function {
+	return &gcpWorkloadIdentityProviderNameFunction{}
+}
+
+// gcpWorkloadIdentityProviderNameFunction builds the full resource name of a
+// GCP workload identity pool provider, as expected by the
+// workload_identity_provider_name attribute of traceforce_post_connection.
+type gcpWorkloadIdentityProviderNameFunction struct{}
+
+var (
+	// gcpProjectNumberPattern matches a GCP project number.
+	gcpProjectNumberPattern = regexp.MustCompile(`^\d+$`)
+
+	// gcpWorkloadIdentityIDPattern matches the ID of a workload identity pool
+	// or pool provider.
+	gcpWorkloadIdentityIDPattern = regexp.MustCompile(`^[a-z0-9-]{4,32}$`)
+)
+
+func (f *gcpWorkloadIdentityProviderNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "gcp_workload_identity_provider_name"
+}
+
+func (f *gcpWorkloadIdentityProviderNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a GCP workload identity provider name",
+		Description: "Returns the full resource name of a GCP workload identity pool provider, " +
+			"projects/<project_number>/locations/global/workloadIdentityPools/<pool>/providers/<provider>, " +
+			"for the workload_identity_provider_name attribute of traceforce_post_connection.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "project_number",
+				Description: "Number of the GCP project owning the workload identity pool. This is the numeric project number, not the project ID.",
+			},
+			function.StringParameter{
+				Name:        "pool",
+				Description: "ID of the workload identity pool, 4 to 32 lowercase letters, digits or hyphens.",
+			},
+			function.StringParameter{
+				Name:        "provider",
+				Description: "ID of the workload identity pool provider, 4 to 32 lowercase letters, digits or hyphens.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *gcpWorkloadIdentityProviderNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var projectNumber, pool, provider string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &projectNumber, &pool, &provider))
+	if resp.Error != nil {
+		return
+	}
+
+	if !gcpProjectNumberPattern.MatchString(projectNumber) {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("expected a numeric GCP project number, got: %q", projectNumber)))
+	}
+	if !gcpWorkloadIdentityIDPattern.MatchString(pool) {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("expected a pool ID of 4 to 32 lowercase letters, digits or hyphens, got: %q", pool)))
+	}
+	if !gcpWorkloadIdentityIDPattern.MatchString(provider) {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, fmt.Sprintf("expected a provider ID of 4 to 32 lowercase letters, digits or hyphens, got: %q", provider)))
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	name := fmt.Sprintf("projects/%s/locations/global/workloadIdentityPools/%s/providers/%s", projectNumber, pool, provider)
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, name))
+}
@@ -0,0 +1,73 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGCPWorkloadIdentityProviderNameFunction(t *testing.T) {
+	testCases := map[string]struct {
+		projectNumber string
+		pool          string
+		provider      string
+		expected      string
+		expectError   bool
+	}{
+		"valid": {
+			projectNumber: "123456789012",
+			pool:          "traceforce-pool",
+			provider:      "traceforce-provider",
+			expected:      "projects/123456789012/locations/global/workloadIdentityPools/traceforce-pool/providers/traceforce-provider",
+		},
+		"project-id-instead-of-number": {
+			projectNumber: "my-gcp-project",
+			pool:          "traceforce-pool",
+			provider:      "traceforce-provider",
+			expectError:   true,
+		},
+		"uppercase-pool": {
+			projectNumber: "123456789012",
+			pool:          "Traceforce-Pool",
+			provider:      "traceforce-provider",
+			expectError:   true,
+		},
+		"short-provider": {
+			projectNumber: "123456789012",
+			pool:          "traceforce-pool",
+			provider:      "tf",
+			expectError:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+			NewGCPWorkloadIdentityProviderNameFunction().Run(t.Context(), function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(testCase.projectNumber),
+					types.StringValue(testCase.pool),
+					types.StringValue(testCase.provider),
+				}),
+			}, resp)
+
+			if testCase.expectError {
+				if resp.Error == nil {
+					t.Error("expected error, got none")
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			if expected := types.StringValue(testCase.expected); !resp.Result.Value().Equal(expected) {
+				t.Errorf("expected %s, got %s", expected, resp.Result.Value())
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// idIdentityModel maps the identity data of resources identified by their
+// system generated ID.
+type idIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// idIdentitySchema returns the identity schema of resources of the given kind
+// identified by their system generated ID.
+func idIdentitySchema(kind string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       fmt.Sprintf("System generated ID of the %s", kind),
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// setIdentity stores identity data in the identity of a CRUD response. The
+// identity is nil when the response was built without an identity schema, as
+// in unit tests, in which case there is nothing to set.
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, data any) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, data)
+}
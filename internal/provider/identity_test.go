@@ -0,0 +1,134 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// identityResource is a resource supporting both identity and import.
+type identityResource interface {
+	resource.ResourceWithIdentity
+	resource.ResourceWithImportState
+}
+
+func TestResourceIdentityRoundTrip(t *testing.T) {
+	f := newFakeAPI(t)
+	account := f.seed(t, "production")
+	projectID, datalakeID, sourceAppID, linkID := account.projectID, account.datalakeID, account.sourceAppID, account.linkID
+	client := f.client()
+
+	testCases := map[string]struct {
+		resource         identityResource
+		state            any
+		expectedIdentity any
+		importPath       path.Path
+		expectedImport   string
+	}{
+		"project": {
+			resource:         &projectResource{client: client},
+			state:            &projectResourceModel{ID: types.StringValue(projectID), Timeouts: nullTimeouts("create", "update")},
+			expectedIdentity: &idIdentityModel{ID: types.StringValue(projectID)},
+			importPath:       path.Root("id"),
+			expectedImport:   projectID,
+		},
+		"datalake": {
+			resource:         &datalakeResource{client: client},
+			state:            &datalakeResourceModel{ID: types.StringValue(datalakeID), Timeouts: nullTimeouts("create")},
+			expectedIdentity: &idIdentityModel{ID: types.StringValue(datalakeID)},
+			importPath:       path.Root("id"),
+			expectedImport:   datalakeID,
+		},
+		"source_app": {
+			resource:         &sourceAppResource{client: client},
+			state:            &sourceAppResourceModel{ID: types.StringValue(sourceAppID)},
+			expectedIdentity: &idIdentityModel{ID: types.StringValue(sourceAppID)},
+			importPath:       path.Root("id"),
+			expectedImport:   sourceAppID,
+		},
+		"source_app_datalake_link": {
+			resource: &sourceAppDatalakeLinkResource{client: client},
+			state:    &sourceAppDatalakeLinkResourceModel{ID: types.StringValue(linkID)},
+			expectedIdentity: &sourceAppDatalakeLinkIdentityModel{
+				SourceAppID: types.StringValue(sourceAppID),
+				DatalakeID:  types.StringValue(datalakeID),
+			},
+			importPath:     path.Root("id"),
+			expectedImport: linkID,
+		},
+		"post_connection": {
+			resource: &postConnectionResource{client: client},
+			state: &postConnectionResourceModel{
+				TraceforceHostingEnvironmentId: types.StringValue(projectID),
+				DeployedDatalakeIds:            types.ListNull(types.StringType),
+				DeployedSourceAppIds:           types.ListNull(types.StringType),
+			},
+			expectedIdentity: &postConnectionIdentityModel{TraceforceHostingEnvironmentId: types.StringValue(projectID)},
+			importPath:       path.Root("traceforce_hosting_environment_id"),
+			expectedImport:   projectID,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+
+			schemaResp := &resource.SchemaResponse{}
+			testCase.resource.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			identitySchemaResp := &resource.IdentitySchemaResponse{}
+			testCase.resource.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identitySchemaResp)
+
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := state.Set(ctx, testCase.state); diags.HasError() {
+				t.Fatalf("unexpected state diagnostics: %v", diags)
+			}
+			nullIdentity := tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil)
+
+			// Read stores the identity of the resource.
+			readResp := &resource.ReadResponse{
+				State:    state,
+				Identity: &tfsdk.ResourceIdentity{Schema: identitySchemaResp.IdentitySchema, Raw: nullIdentity},
+			}
+			testCase.resource.Read(ctx, resource.ReadRequest{State: state}, readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+			}
+
+			expectedIdentity := tfsdk.ResourceIdentity{Schema: identitySchemaResp.IdentitySchema}
+			if diags := expectedIdentity.Set(ctx, testCase.expectedIdentity); diags.HasError() {
+				t.Fatalf("unexpected identity diagnostics: %v", diags)
+			}
+			if !readResp.Identity.Raw.Equal(expectedIdentity.Raw) {
+				t.Fatalf("expected identity %s, got %s", expectedIdentity.Raw, readResp.Identity.Raw)
+			}
+
+			// Importing by that identity resolves the same resource.
+			importResp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+				Identity: &tfsdk.ResourceIdentity{Schema: identitySchemaResp.IdentitySchema, Raw: readResp.Identity.Raw.Copy()},
+			}
+			testCase.resource.ImportState(ctx, resource.ImportStateRequest{Identity: readResp.Identity}, importResp)
+			if importResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected import diagnostics: %v", importResp.Diagnostics)
+			}
+
+			var imported types.String
+			if diags := importResp.State.GetAttribute(ctx, testCase.importPath, &imported); diags.HasError() {
+				t.Fatalf("unexpected state diagnostics: %v", diags)
+			}
+			if imported.ValueString() != testCase.expectedImport {
+				t.Errorf("expected %s %q, got %q", testCase.importPath, testCase.expectedImport, imported.ValueString())
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listResults streams a list result for each object. describe returns the
+// display name, identity data and resource data of an object. The resource
+// data is only stored when Terraform requests it.
+func listResults[T any](ctx context.Context, req list.ListRequest, objects []T, describe func(T) (string, any, any)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for _, object := range objects {
+			displayName, identity, resource := describe(object)
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = displayName
+			result.Diagnostics.Append(result.Identity.Set(ctx, identity)...)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, resource)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// listError returns a stream reporting that listing failed.
+func listError(summary string, err error) iter.Seq[list.ListResult] {
+	var diags diag.Diagnostics
+	diags.Append(apiErrorDiagnostic(summary, err))
+	return list.ListResultsStreamDiagnostics(diags)
+}
+
+// nullTimeouts returns an unset timeouts block with the given operations.
+func nullTimeouts(operations ...string) timeouts.Value {
+	attrTypes := make(map[string]attr.Type, len(operations))
+	for _, operation := range operations {
+		attrTypes[operation] = types.StringType
+	}
+
+	return timeouts.Value{Object: types.ObjectNull(attrTypes)}
+}
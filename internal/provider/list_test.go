@@ -0,0 +1,192 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+// listResource is a list resource sharing its metadata and configuration with
+// a managed resource supporting identity.
+type listResource interface {
+	list.ListResourceWithConfigure
+	resource.ResourceWithIdentity
+}
+
+func TestListResources(t *testing.T) {
+	projectID1, projectID2 := uuid.New().String(), uuid.New().String()
+	datalakeID1, datalakeID2 := uuid.New().String(), uuid.New().String()
+	sourceAppID1, sourceAppID2 := uuid.New().String(), uuid.New().String()
+	linkID1, linkID2, linkID3 := uuid.New().String(), uuid.New().String(), uuid.New().String()
+
+	f := newFakeAPI(t)
+	f.add(
+		traceforce.HostingEnvironment{ID: projectID1, Name: "production", CloudProvider: traceforce.CloudProviderGCP},
+		traceforce.HostingEnvironment{ID: projectID2, Name: "staging", CloudProvider: traceforce.CloudProviderAWS},
+		traceforce.Datalake{ID: datalakeID1, Name: "analytics", HostingEnvironmentID: projectID1},
+		traceforce.Datalake{ID: datalakeID2, Name: "warehouse", HostingEnvironmentID: projectID2},
+		traceforce.SourceApp{ID: sourceAppID1, Name: "salesforce-prod", HostingEnvironmentID: projectID1},
+		traceforce.SourceApp{ID: sourceAppID2, Name: "hubspot-prod", HostingEnvironmentID: projectID2},
+		traceforce.SourceAppDatalakeLink{ID: linkID1, SourceAppID: sourceAppID1, DatalakeID: datalakeID1},
+		traceforce.SourceAppDatalakeLink{ID: linkID2, SourceAppID: sourceAppID1, DatalakeID: datalakeID2},
+		traceforce.SourceAppDatalakeLink{ID: linkID3, SourceAppID: sourceAppID2, DatalakeID: datalakeID2},
+	)
+	client := f.client()
+
+	testCases := map[string]struct {
+		listResource         listResource
+		config               any
+		expectedDisplayNames []string
+		expectedIDs          []string
+	}{
+		"projects": {
+			listResource:         &projectListResource{},
+			config:               &projectListModel{},
+			expectedDisplayNames: []string{"production", "staging"},
+			expectedIDs:          []string{projectID1, projectID2},
+		},
+		"projects-by-cloud-provider": {
+			listResource:         &projectListResource{},
+			config:               &projectListModel{CloudProvider: types.StringValue(string(traceforce.CloudProviderAWS))},
+			expectedDisplayNames: []string{"staging"},
+			expectedIDs:          []string{projectID2},
+		},
+		"datalakes": {
+			listResource:         &datalakeListResource{},
+			config:               &datalakeListModel{},
+			expectedDisplayNames: []string{"analytics", "warehouse"},
+			expectedIDs:          []string{datalakeID1, datalakeID2},
+		},
+		"datalakes-by-project": {
+			listResource:         &datalakeListResource{},
+			config:               &datalakeListModel{ProjectId: types.StringValue(projectID2)},
+			expectedDisplayNames: []string{"warehouse"},
+			expectedIDs:          []string{datalakeID2},
+		},
+		"source-apps-by-hosting-environment": {
+			listResource:         &sourceAppListResource{},
+			config:               &sourceAppListModel{HostingEnvironmentId: types.StringValue(projectID1)},
+			expectedDisplayNames: []string{"salesforce-prod"},
+			expectedIDs:          []string{sourceAppID1},
+		},
+		"links": {
+			listResource:         &sourceAppDatalakeLinkListResource{},
+			config:               &sourceAppDatalakeLinkListModel{},
+			expectedDisplayNames: []string{sourceAppID1 + "/" + datalakeID1, sourceAppID1 + "/" + datalakeID2, sourceAppID2 + "/" + datalakeID2},
+			expectedIDs:          []string{linkID1, linkID2, linkID3},
+		},
+		"links-by-source-app-and-datalake": {
+			listResource: &sourceAppDatalakeLinkListResource{},
+			config: &sourceAppDatalakeLinkListModel{
+				SourceAppID: types.StringValue(sourceAppID1),
+				DatalakeID:  types.StringValue(datalakeID2),
+			},
+			expectedDisplayNames: []string{sourceAppID1 + "/" + datalakeID2},
+			expectedIDs:          []string{linkID2},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+
+			configureResp := &resource.ConfigureResponse{}
+			testCase.listResource.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, configureResp)
+			if configureResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+			}
+
+			configSchemaResp := &list.ListResourceSchemaResponse{}
+			testCase.listResource.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, configSchemaResp)
+			schemaResp := &resource.SchemaResponse{}
+			testCase.listResource.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			identitySchemaResp := &resource.IdentitySchemaResponse{}
+			testCase.listResource.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identitySchemaResp)
+
+			// Build the configuration through a state, which supports setting
+			// a whole model.
+			configState := tfsdk.State{Schema: configSchemaResp.Schema}
+			if diags := configState.Set(ctx, testCase.config); diags.HasError() {
+				t.Fatalf("unexpected config diagnostics: %v", diags)
+			}
+
+			stream := &list.ListResultsStream{}
+			testCase.listResource.List(ctx, list.ListRequest{
+				Config:                 tfsdk.Config{Schema: configSchemaResp.Schema, Raw: configState.Raw},
+				IncludeResource:        true,
+				ResourceSchema:         schemaResp.Schema,
+				ResourceIdentitySchema: identitySchemaResp.IdentitySchema,
+			}, stream)
+
+			var displayNames, ids []string
+			for result := range stream.Results {
+				if result.Diagnostics.HasError() {
+					t.Fatalf("unexpected result diagnostics: %v", result.Diagnostics)
+				}
+				if result.Identity.Raw.IsNull() {
+					t.Errorf("expected identity for %q", result.DisplayName)
+				}
+
+				var id types.String
+				if diags := result.Resource.GetAttribute(ctx, path.Root("id"), &id); diags.HasError() {
+					t.Fatalf("unexpected resource diagnostics: %v", diags)
+				}
+
+				displayNames = append(displayNames, result.DisplayName)
+				ids = append(ids, id.ValueString())
+			}
+
+			if !slices.Equal(displayNames, testCase.expectedDisplayNames) {
+				t.Errorf("expected display names %v, got %v", testCase.expectedDisplayNames, displayNames)
+			}
+			if !slices.Equal(ids, testCase.expectedIDs) {
+				t.Errorf("expected ids %v, got %v", testCase.expectedIDs, ids)
+			}
+		})
+	}
+}
+
+func TestProviderListResourcesMetadata(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("unexpected provider server error: %v", err)
+	}
+
+	resp, err := server.GetMetadata(t.Context(), &tfprotov6.GetMetadataRequest{})
+	if err != nil {
+		t.Fatalf("unexpected metadata error: %v", err)
+	}
+	for _, diagnostic := range resp.Diagnostics {
+		if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("unexpected metadata diagnostic: %s: %s", diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+
+	var typeNames []string
+	for _, listResource := range resp.ListResources {
+		typeNames = append(typeNames, listResource.TypeName)
+	}
+	slices.Sort(typeNames)
+
+	expected := []string{
+		"traceforce_datalake",
+		"traceforce_project",
+		"traceforce_source_app",
+		"traceforce_source_app_datalake_link",
+	}
+	if !slices.Equal(typeNames, expected) {
+		t.Errorf("expected list resources %v, got %v", expected, typeNames)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// logSubsystem is the tflog subsystem API calls are logged to. Requests are
+// logged at DEBUG, and request and response bodies at TRACE.
+const logSubsystem = "traceforce"
+
+// Log field keys of the API call logs.
+const (
+	logFieldMethod       = "http_method"
+	logFieldPath         = "http_path"
+	logFieldStatus       = "http_status"
+	logFieldLatency      = "http_latency_ms"
+	logFieldAttempt      = "http_attempt"
+	logFieldRequestID    = "request_id"
+	logFieldRequestBody  = "http_request_body"
+	logFieldResponseBody = "http_response_body"
+	logFieldError        = "error"
+
+	// logFieldHeaderPrefix prefixes the key of each logged request header.
+	logFieldHeaderPrefix = "http_request_header_"
+)
+
+// salesforceSecretPattern matches the Salesforce client secret in request and
+// response bodies.
+var salesforceSecretPattern = regexp.MustCompile(`"salesforce_client_secret(?:_wo)?"\s*:\s*"(?:[^"\\]|\\.)*"`)
+
+// logContext returns ctx with the API logging subsystem, masking the API key,
+// the values of the extra headers and Salesforce secrets.
+func (c *apiClient) logContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, logSubsystem)
+
+	maskedKeys := []string{headerLogField("Authorization")}
+	secrets := []string{c.apiKey}
+	for k, v := range c.extraHeaders {
+		maskedKeys = append(maskedKeys, headerLogField(k))
+		if v != "" {
+			secrets = append(secrets, v)
+		}
+	}
+
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, logSubsystem, maskedKeys...)
+	ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, logSubsystem, secrets...)
+	ctx = tflog.SubsystemMaskMessageStrings(ctx, logSubsystem, secrets...)
+	ctx = tflog.SubsystemMaskAllFieldValuesRegexes(ctx, logSubsystem, salesforceSecretPattern)
+	return ctx
+}
+
+// headerLogField returns the log field key of a request header.
+func headerLogField(name string) string {
+	return logFieldHeaderPrefix + strings.ToLower(name)
+}
+
+// logRequest logs an HTTP attempt against the API once it completed.
+func logRequest(ctx context.Context, req *http.Request, attempt int, resp *http.Response, err error, latency time.Duration) {
+	fields := map[string]any{
+		logFieldMethod:  req.Method,
+		logFieldPath:    req.URL.RequestURI(),
+		logFieldAttempt: attempt + 1,
+		logFieldLatency: latency.Milliseconds(),
+	}
+
+	if err != nil {
+		fields[logFieldError] = err.Error()
+		tflog.SubsystemDebug(ctx, logSubsystem, "Traceforce API request failed", fields)
+		return
+	}
+
+	fields[logFieldStatus] = resp.StatusCode
+	if requestID := resp.Header.Get(requestIDHeader); requestID != "" {
+		fields[logFieldRequestID] = requestID
+	}
+	tflog.SubsystemDebug(ctx, logSubsystem, "Traceforce API request", fields)
+}
+
+// logRequestBody logs the headers and body of a request at TRACE.
+func logRequestBody(ctx context.Context, req *http.Request, payload []byte) {
+	fields := map[string]any{
+		logFieldMethod: req.Method,
+		logFieldPath:   req.URL.RequestURI(),
+	}
+	for name := range req.Header {
+		fields[headerLogField(name)] = req.Header.Get(name)
+	}
+	if payload != nil {
+		fields[logFieldRequestBody] = string(payload)
+	}
+	tflog.SubsystemTrace(ctx, logSubsystem, "Sending Traceforce API request", fields)
+}
+
+// logResponseBody logs the body of a response at TRACE.
+func logResponseBody(ctx context.Context, resp *http.Response, body []byte) {
+	tflog.SubsystemTrace(ctx, logSubsystem, "Received Traceforce API response", map[string]any{
+		logFieldMethod:       resp.Request.Method,
+		logFieldPath:         resp.Request.URL.RequestURI(),
+		logFieldStatus:       resp.StatusCode,
+		logFieldResponseBody: string(body),
+	})
+}
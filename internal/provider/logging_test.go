@@ -0,0 +1,83 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+func TestAPIClientLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIDHeader, "req-123")
+		_, _ = w.Write([]byte(`{"id":"post-connection","infrastructure":{"salesforce":{"salesforce_client_secret":"projects/p/secrets/response-secret"}}}`))
+	}))
+	defer server.Close()
+
+	client := mustAPIClient("api-key-secret", server.URL, apiClientOptions{
+		ExtraHeaders: map[string]string{"X-Tenant-Token": "header-secret"},
+	})
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &output)
+
+	err := client.PostConnection(ctx, "1b4e28ba-2fa1-11d2-883f-0016d3cca427", &traceforce.PostConnectionRequest{
+		Infrastructure: &traceforce.Infrastructure{
+			Salesforce: &traceforce.SalesforceInfrastructure{
+				ClientID:     "client-id",
+				Domain:       "example.my.salesforce.com",
+				ClientSecret: "projects/p/secrets/request-secret",
+			},
+		},
+		TerraformModuleVersions: `{"base_infrastructure":{"major":1,"minor":0}}`,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, secret := range []string{"api-key-secret", "header-secret", "request-secret", "response-secret"} {
+		if strings.Contains(output.String(), secret) {
+			t.Errorf("expected %q to be masked in the logs:\n%s", secret, output.String())
+		}
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unexpected error decoding logs: %v", err)
+	}
+
+	var request map[string]any
+	for _, entry := range entries {
+		if entry["@message"] == "Traceforce API request" {
+			request = entry
+		}
+	}
+	if request == nil {
+		t.Fatalf("expected the request to be logged, got: %v", entries)
+	}
+
+	expectedFields := map[string]any{
+		"@level":          "debug",
+		"@module":         "provider." + logSubsystem,
+		logFieldMethod:    http.MethodPost,
+		logFieldPath:      "/hosting-environments/1b4e28ba-2fa1-11d2-883f-0016d3cca427/post-connection",
+		logFieldStatus:    float64(http.StatusOK),
+		logFieldRequestID: "req-123",
+		logFieldAttempt:   float64(1),
+	}
+	for key, expected := range expectedFields {
+		if request[key] != expected {
+			t.Errorf("expected %s to be %v, got %v", key, expected, request[key])
+		}
+	}
+	if _, ok := request[logFieldLatency]; !ok {
+		t.Errorf("expected latency to be logged, got: %v", request)
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// lookupOne returns the only element of matches, the objects found by a
+// singular data source or a natural-key import. The lookup is described by
+// description in diagnostics. When there is no match or more than one, it adds
+// an error diagnostic naming the kind of object and returns false.
+func lookupOne[T any](matches []T, id func(T) string, kind, description string, diags *diag.Diagnostics) (T, bool) {
+	var zero T
+
+	switch len(matches) {
+	case 0:
+		diags.AddError(fmt.Sprintf("No %s found", kind), fmt.Sprintf("No %s matches %s.", kind, description))
+		return zero, false
+	case 1:
+		return matches[0], true
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, match := range matches {
+			ids = append(ids, id(match))
+		}
+		diags.AddError(
+			fmt.Sprintf("Multiple %ss found", kind),
+			fmt.Sprintf("%d %ss match %s: %s. Use the id of the %s instead.", len(matches), kind, description, strings.Join(ids, ", "), kind),
+		)
+		return zero, false
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &moduleVersionsFunction{}
+
+// NewModuleVersionsFunction creates a new module_versions function.
+func NewModuleVersionsFunction() function.Function {
+	return &moduleVersionsFunction{}
+}
+
+// moduleVersionsFunction builds the terraform_module_versions attribute of
+// traceforce_post_connection from module version strings.
+type moduleVersionsFunction struct{}
+
+// moduleVersionPattern matches a module version of the form
+// [v]MAJOR.MINOR[.PATCH].
+var moduleVersionPattern = regexp.MustCompile(`^v?(\d+)\.(\d+)(?:\.\d+)?$`)
+
+// moduleVersionAttributeTypes are the attribute types of a module version
+// object.
+var moduleVersionAttributeTypes = map[string]attr.Type{
+	"major": types.Int64Type,
+	"minor": types.Int64Type,
+}
+
+func (f *moduleVersionsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "module_versions"
+}
+
+func (f *moduleVersionsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build the Terraform module versions of a post-connection",
+		Description: "Returns the terraform_module_versions object of traceforce_post_connection from module version strings " +
+			"of the form [v]MAJOR.MINOR[.PATCH]. Patch versions are not recorded and are ignored.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "base_infrastructure",
+				Description: "Version of the base infrastructure module.",
+			},
+			function.MapParameter{
+				Name:           "datalake_connectors",
+				Description:    "Versions of the datalake connector modules, keyed by datalake type (e.g., bigquery). May be null.",
+				ElementType:    types.StringType,
+				AllowNullValue: true,
+			},
+			function.MapParameter{
+				Name:           "source_connectors",
+				Description:    "Versions of the source connector modules, keyed by source app type (e.g., salesforce). May be null.",
+				ElementType:    types.StringType,
+				AllowNullValue: true,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"base_infrastructure": types.ObjectType{AttrTypes: moduleVersionAttributeTypes},
+				"datalake_connectors": types.MapType{ElemType: types.ObjectType{AttrTypes: moduleVersionAttributeTypes}},
+				"source_connectors":   types.MapType{ElemType: types.ObjectType{AttrTypes: moduleVersionAttributeTypes}},
+			},
+		},
+	}
+}
+
+func (f *moduleVersionsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var baseInfrastructure string
+	var datalakeConnectors, sourceConnectors types.Map
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &baseInfrastructure, &datalakeConnectors, &sourceConnectors))
+	if resp.Error != nil {
+		return
+	}
+
+	var result terraformModuleVersionsModel
+	var funcErr *function.FuncError
+
+	version, err := parseModuleVersion(baseInfrastructure)
+	if err != nil {
+		funcErr = function.ConcatFuncErrors(funcErr, function.NewArgumentFuncError(0, err.Error()))
+	} else {
+		result.BaseInfrastructure = &version
+	}
+
+	result.DatalakeConnectors, err = parseModuleVersionMap(ctx, datalakeConnectors)
+	if err != nil {
+		funcErr = function.ConcatFuncErrors(funcErr, function.NewArgumentFuncError(1, err.Error()))
+	}
+	result.SourceConnectors, err = parseModuleVersionMap(ctx, sourceConnectors)
+	if err != nil {
+		funcErr = function.ConcatFuncErrors(funcErr, function.NewArgumentFuncError(2, err.Error()))
+	}
+
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, &result))
+}
+
+// parseModuleVersion parses a module version of the form [v]MAJOR.MINOR[.PATCH].
+func parseModuleVersion(version string) (moduleVersionModel, error) {
+	matches := moduleVersionPattern.FindStringSubmatch(version)
+	if matches == nil {
+		return moduleVersionModel{}, fmt.Errorf("expected a module version with format [v]MAJOR.MINOR[.PATCH], got: %q", version)
+	}
+
+	major, err := strconv.ParseInt(matches[1], 10, 64)
+	if err != nil {
+		return moduleVersionModel{}, fmt.Errorf("invalid major version in %q: %s", version, err)
+	}
+	minor, err := strconv.ParseInt(matches[2], 10, 64)
+	if err != nil {
+		return moduleVersionModel{}, fmt.Errorf("invalid minor version in %q: %s", version, err)
+	}
+
+	return moduleVersionModel{Major: types.Int64Value(major), Minor: types.Int64Value(minor)}, nil
+}
+
+// parseModuleVersionMap parses a map of module versions keyed by connector
+// type. Null and empty maps are returned as nil, matching how
+// traceforce_post_connection stores connectors the API omits.
+func parseModuleVersionMap(ctx context.Context, versions types.Map) (map[string]moduleVersionModel, error) {
+	if versions.IsNull() || len(versions.Elements()) == 0 {
+		return nil, nil
+	}
+
+	var raw map[string]string
+	if diags := versions.ElementsAs(ctx, &raw, false); diags.HasError() {
+		return nil, fmt.Errorf("expected a map of module version strings: %v", diags)
+	}
+
+	result := make(map[string]moduleVersionModel, len(raw))
+	for name, version := range raw {
+		parsed, err := parseModuleVersion(version)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		result[name] = parsed
+	}
+	return result, nil
+}
@@ -0,0 +1,103 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestModuleVersionsFunction(t *testing.T) {
+	versionType := types.ObjectType{AttrTypes: moduleVersionAttributeTypes}
+	versionsType := types.MapType{ElemType: versionType}
+	resultTypes := map[string]attr.Type{
+		"base_infrastructure": versionType,
+		"datalake_connectors": versionsType,
+		"source_connectors":   versionsType,
+	}
+
+	version := func(major, minor int64) attr.Value {
+		return types.ObjectValueMust(moduleVersionAttributeTypes, map[string]attr.Value{
+			"major": types.Int64Value(major),
+			"minor": types.Int64Value(minor),
+		})
+	}
+	versionStrings := func(versions map[string]string) types.Map {
+		values := make(map[string]attr.Value, len(versions))
+		for name, version := range versions {
+			values[name] = types.StringValue(version)
+		}
+		return types.MapValueMust(types.StringType, values)
+	}
+
+	testCases := map[string]struct {
+		baseInfrastructure string
+		datalakeConnectors types.Map
+		sourceConnectors   types.Map
+		expected           attr.Value
+		expectError        bool
+	}{
+		"all-modules": {
+			baseInfrastructure: "v1.4.2",
+			datalakeConnectors: versionStrings(map[string]string{"bigquery": "2.0"}),
+			sourceConnectors:   versionStrings(map[string]string{"salesforce": "0.3.1"}),
+			expected: types.ObjectValueMust(resultTypes, map[string]attr.Value{
+				"base_infrastructure": version(1, 4),
+				"datalake_connectors": types.MapValueMust(versionType, map[string]attr.Value{"bigquery": version(2, 0)}),
+				"source_connectors":   types.MapValueMust(versionType, map[string]attr.Value{"salesforce": version(0, 3)}),
+			}),
+		},
+		"null-and-empty-connectors": {
+			baseInfrastructure: "1.4",
+			datalakeConnectors: types.MapNull(types.StringType),
+			sourceConnectors:   versionStrings(map[string]string{}),
+			expected: types.ObjectValueMust(resultTypes, map[string]attr.Value{
+				"base_infrastructure": version(1, 4),
+				"datalake_connectors": types.MapNull(versionType),
+				"source_connectors":   types.MapNull(versionType),
+			}),
+		},
+		"invalid-base-infrastructure": {
+			baseInfrastructure: "latest",
+			datalakeConnectors: types.MapNull(types.StringType),
+			sourceConnectors:   types.MapNull(types.StringType),
+			expectError:        true,
+		},
+		"invalid-connector": {
+			baseInfrastructure: "1.4",
+			datalakeConnectors: versionStrings(map[string]string{"bigquery": "2"}),
+			sourceConnectors:   types.MapNull(types.StringType),
+			expectError:        true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp := &function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(resultTypes))}
+			NewModuleVersionsFunction().Run(t.Context(), function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(testCase.baseInfrastructure),
+					testCase.datalakeConnectors,
+					testCase.sourceConnectors,
+				}),
+			}, resp)
+
+			if testCase.expectError {
+				if resp.Error == nil {
+					t.Error("expected error, got none")
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			if !resp.Result.Value().Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, resp.Result.Value())
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &parseLinkIDFunction{}
+
+// NewParseLinkIDFunction creates a new parse_link_id function.
+func NewParseLinkIDFunction() function.Function {
+	return &parseLinkIDFunction{}
+}
+
+// parseLinkIDFunction splits a source app datalake link identifier into the
+// IDs of the linked source app and datalake.
+type parseLinkIDFunction struct{}
+
+// parsedLinkIDModel maps the object returned by parse_link_id.
+type parsedLinkIDModel struct {
+	SourceAppID types.String `tfsdk:"source_app_id"`
+	DatalakeID  types.String `tfsdk:"datalake_id"`
+}
+
+func (f *parseLinkIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_link_id"
+}
+
+func (f *parseLinkIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a source app datalake link identifier",
+		Description: "Splits a source app datalake link identifier of the form <source_app_id>/<datalake_id>, " +
+			"as accepted when importing traceforce_source_app_datalake_link, into an object with source_app_id and datalake_id attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "Link identifier of the form <source_app_id>/<datalake_id>.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"source_app_id": types.StringType,
+				"datalake_id":   types.StringType,
+			},
+		},
+	}
+}
+
+func (f *parseLinkIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	sourceAppID, datalakeID, ok := parseLinkID(id)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("expected a link identifier with format <source_app_id>/<datalake_id>, got: %q", id))
+		return
+	}
+
+	result := parsedLinkIDModel{
+		SourceAppID: types.StringValue(sourceAppID),
+		DatalakeID:  types.StringValue(datalakeID),
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, &result))
+}
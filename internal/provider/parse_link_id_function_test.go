@@ -0,0 +1,67 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseLinkIDFunction(t *testing.T) {
+	attributeTypes := map[string]attr.Type{
+		"source_app_id": types.StringType,
+		"datalake_id":   types.StringType,
+	}
+
+	testCases := map[string]struct {
+		id          string
+		expected    attr.Value
+		expectError bool
+	}{
+		"valid": {
+			id: "source-app-id/datalake-id",
+			expected: types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+				"source_app_id": types.StringValue("source-app-id"),
+				"datalake_id":   types.StringValue("datalake-id"),
+			}),
+		},
+		"missing-separator": {
+			id:          "link-id",
+			expectError: true,
+		},
+		"empty-part": {
+			id:          "source-app-id/",
+			expectError: true,
+		},
+		"too-many-parts": {
+			id:          "source-app-id/datalake-id/extra",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp := &function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(attributeTypes))}
+			NewParseLinkIDFunction().Run(t.Context(), function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(testCase.id)}),
+			}, resp)
+
+			if testCase.expectError {
+				if resp.Error == nil {
+					t.Error("expected error, got none")
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			if !resp.Result.Value().Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, resp.Result.Value())
+			}
+		})
+	}
+}
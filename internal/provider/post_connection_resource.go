@@ -5,19 +5,33 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	traceforce "github.com/traceforce/traceforce-go-sdk"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &postConnectionResource{}
-	_ resource.ResourceWithConfigure   = &postConnectionResource{}
-	_ resource.ResourceWithImportState = &postConnectionResource{}
+	_ resource.Resource                 = &postConnectionResource{}
+	_ resource.ResourceWithConfigure    = &postConnectionResource{}
+	_ resource.ResourceWithImportState  = &postConnectionResource{}
+	_ resource.ResourceWithIdentity     = &postConnectionResource{}
+	_ resource.ResourceWithUpgradeState = &postConnectionResource{}
 )
 
 // NewPostConnectionResource creates a new post connection resource.
@@ -27,7 +41,7 @@ func NewPostConnectionResource() resource.Resource {
 
 // postConnectionResource is the resource implementation.
 type postConnectionResource struct {
-	client *traceforce.Client
+	client *apiClient
 }
 
 // baseInfrastructureModel maps base infrastructure schema data.
@@ -55,19 +69,50 @@ type bigqueryInfrastructureModel struct {
 
 // salesforceInfrastructureModel maps salesforce infrastructure schema data.
 type salesforceInfrastructureModel struct {
-	ClientID     types.String `tfsdk:"salesforce_client_id"`
-	Domain       types.String `tfsdk:"salesforce_domain"`
-	ClientSecret types.String `tfsdk:"salesforce_client_secret"`
+	ClientID              types.String `tfsdk:"salesforce_client_id"`
+	Domain                types.String `tfsdk:"salesforce_domain"`
+	ClientSecret          types.String `tfsdk:"salesforce_client_secret"`
+	ClientSecretWO        types.String `tfsdk:"salesforce_client_secret_wo"`
+	ClientSecretWOVersion types.Int64  `tfsdk:"salesforce_client_secret_wo_version"`
+}
+
+// moduleVersionModel maps the version of a single Terraform module.
+type moduleVersionModel struct {
+	Major types.Int64 `tfsdk:"major"`
+	Minor types.Int64 `tfsdk:"minor"`
 }
 
+// terraformModuleVersionsModel maps terraform_module_versions schema data.
+type terraformModuleVersionsModel struct {
+	BaseInfrastructure *moduleVersionModel           `tfsdk:"base_infrastructure"`
+	DatalakeConnectors map[string]moduleVersionModel `tfsdk:"datalake_connectors"`
+	SourceConnectors   map[string]moduleVersionModel `tfsdk:"source_connectors"`
+}
+
+// Values of the delete_behavior attribute.
+const (
+	// postConnectionDeleteAbandon only removes the resource from the Terraform
+	// state, leaving the hosting environment connected.
+	postConnectionDeleteAbandon = "abandon"
+)
+
 // postConnectionResourceModel maps post_connection schema data.
 type postConnectionResourceModel struct {
-	TraceforceHostingEnvironmentId types.String        `tfsdk:"traceforce_hosting_environment_id"`
-	Infrastructure                 infrastructureModel `tfsdk:"infrastructure"`
-	TerraformURL                   types.String        `tfsdk:"terraform_url"`
-	TerraformModuleVersions        types.String        `tfsdk:"terraform_module_versions"`
-	DeployedDatalakeIds            types.List          `tfsdk:"deployed_datalake_ids"`
-	DeployedSourceAppIds           types.List          `tfsdk:"deployed_source_app_ids"`
+	ID                             types.String                  `tfsdk:"id"`
+	TraceforceHostingEnvironmentId types.String                  `tfsdk:"traceforce_hosting_environment_id"`
+	Infrastructure                 *infrastructureModel          `tfsdk:"infrastructure"`
+	TerraformURL                   types.String                  `tfsdk:"terraform_url"`
+	TerraformModuleVersions        *terraformModuleVersionsModel `tfsdk:"terraform_module_versions"`
+	DeployedDatalakeIds            types.List                    `tfsdk:"deployed_datalake_ids"`
+	DeployedSourceAppIds           types.List                    `tfsdk:"deployed_source_app_ids"`
+	DeleteBehavior                 types.String                  `tfsdk:"delete_behavior"`
+	Status                         types.String                  `tfsdk:"status"`
+}
+
+// postConnectionIdentityModel maps post_connection identity data. A
+// post-connection is identified by its hosting environment.
+type postConnectionIdentityModel struct {
+	TraceforceHostingEnvironmentId types.String `tfsdk:"traceforce_hosting_environment_id"`
 }
 
 // Metadata returns the resource type name.
@@ -82,7 +127,7 @@ func (r *postConnectionResource) Configure(ctx context.Context, req resource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*traceforce.Client)
+	client, ok := req.ProviderData.(*apiClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
@@ -99,10 +144,19 @@ func (r *postConnectionResource) Configure(ctx context.Context, req resource.Con
 // Schema defines the schema for the resource.
 func (r *postConnectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Description: "Applies the post-connection configuration to a hosting environment. " +
+			"The Traceforce API does not expose the applied configuration, so changes made outside of Terraform are not detected: " +
+			"a refresh only reads the id and status of the hosting environment.",
+		// Version 1 replaced the terraform_module_versions JSON string with a
+		// nested attribute.
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"traceforce_hosting_environment_id": schema.StringAttribute{
 				Description: "ID of the TraceForce hosting environment to post-connect.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"infrastructure": schema.SingleNestedAttribute{
 				Description: "Infrastructure configuration for deployment",
@@ -165,8 +219,28 @@ func (r *postConnectionResource) Schema(_ context.Context, _ resource.SchemaRequ
 								Required:    true,
 							},
 							"salesforce_client_secret": schema.StringAttribute{
-								Description: "Secret Manager resource name for Salesforce client secret",
-								Required:    true,
+								Description: "Secret Manager resource name for Salesforce client secret. " +
+									"Exactly one of salesforce_client_secret or salesforce_client_secret_wo must be set.",
+								Optional:  true,
+								Sensitive: true,
+								Validators: []validator.String{
+									stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("salesforce_client_secret_wo")),
+								},
+							},
+							"salesforce_client_secret_wo": schema.StringAttribute{
+								Description: "Write-only Secret Manager resource name for Salesforce client secret, which is never stored in the Terraform state. " +
+									"Change salesforce_client_secret_wo_version to send a new value. Requires Terraform 1.11 or later.",
+								Optional:  true,
+								Sensitive: true,
+								WriteOnly: true,
+							},
+							"salesforce_client_secret_wo_version": schema.Int64Attribute{
+								Description: "Version of salesforce_client_secret_wo. The write-only secret is only sent to the API when the post-connection is created or updated, " +
+									"so change this value to update the secret.",
+								Optional: true,
+								Validators: []validator.Int64{
+									int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("salesforce_client_secret_wo")),
+								},
 							},
 						},
 					},
@@ -176,9 +250,30 @@ func (r *postConnectionResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Description: "URL of the Terraform module repository",
 				Required:    true,
 			},
-			"terraform_module_versions": schema.StringAttribute{
-				Description: "JSON string containing Terraform module versions",
+			"terraform_module_versions": schema.SingleNestedAttribute{
+				Description: "Versions of the Terraform modules that deployed the infrastructure",
 				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"base_infrastructure": schema.SingleNestedAttribute{
+						Description: "Version of the base infrastructure module",
+						Required:    true,
+						Attributes:  moduleVersionAttributes(),
+					},
+					"datalake_connectors": schema.MapNestedAttribute{
+						Description: "Versions of the datalake connector modules, keyed by datalake type (e.g., bigquery)",
+						Optional:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: moduleVersionAttributes(),
+						},
+					},
+					"source_connectors": schema.MapNestedAttribute{
+						Description: "Versions of the source connector modules, keyed by source app type (e.g., salesforce)",
+						Optional:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: moduleVersionAttributes(),
+						},
+					},
+				},
 			},
 			"deployed_datalake_ids": schema.ListAttribute{
 				Description: "List of datalake IDs that were deployed by terraform",
@@ -190,6 +285,44 @@ func (r *postConnectionResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Required:    true,
 				ElementType: types.StringType,
 			},
+			"delete_behavior": schema.StringAttribute{
+				Description: fmt.Sprintf("What happens to the hosting environment when the resource is destroyed. "+
+					"The only supported value is %s: the resource is only removed from the Terraform state and the hosting environment stays connected. "+
+					"Disconnecting the hosting environment is not supported by the Traceforce API yet. "+
+					"Defaults to %s.",
+					postConnectionDeleteAbandon,
+					postConnectionDeleteAbandon),
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(postConnectionDeleteAbandon),
+				Validators: []validator.String{
+					stringvalidator.OneOf(postConnectionDeleteAbandon),
+				},
+			},
+			// The following attributes are computed and should never be reflected in changes.
+			"id": schema.StringAttribute{
+				Description: "ID of the hosting environment the post-connection applies to",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the hosting environment the post-connection applies to",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *postConnectionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"traceforce_hosting_environment_id": identityschema.StringAttribute{
+				Description:       "ID of the hosting environment the post-connection applies to.",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -204,21 +337,43 @@ func (r *postConnectionResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	if err := r.executePostConnection(ctx, plan); err != nil {
-		resp.Diagnostics.AddError("Error executing post-connection", err.Error())
+	err := r.executePostConnection(ctx, plan, req.Config)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error executing post-connection", err))
+		return
+	}
+
+	err = r.setComputedAttributes(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error reading post-connection", err))
 		return
 	}
 
-	// Set state with the plan data
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, postConnectionIdentityModel{TraceforceHostingEnvironmentId: plan.TraceforceHostingEnvironmentId})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
-// executePostConnection handles the post-connection logic.
-func (r *postConnectionResource) executePostConnection(ctx context.Context, plan postConnectionResourceModel) error {
+// setComputedAttributes sets the computed attributes of a post-connection that
+// was just applied. The API does not return the applied post-connection, so
+// id and status are read from the hosting environment.
+func (r *postConnectionResource) setComputedAttributes(ctx context.Context, model *postConnectionResourceModel) error {
+	hostingEnvironment, err := r.client.GetHostingEnvironment(ctx, model.TraceforceHostingEnvironmentId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	model.ID = types.StringValue(hostingEnvironment.ID)
+	model.Status = types.StringValue(string(hostingEnvironment.Status))
+	return nil
+}
+
+// executePostConnection sends the planned post-connection to the API. Write-only
+// attributes are never part of the plan, so they are read from config.
+func (r *postConnectionResource) executePostConnection(ctx context.Context, plan postConnectionResourceModel, config tfsdk.Config) error {
 	postConnReq := &traceforce.PostConnectionRequest{
 		Infrastructure: &traceforce.Infrastructure{},
 	}
@@ -252,11 +407,24 @@ func (r *postConnectionResource) executePostConnection(ctx context.Context, plan
 			Domain:       plan.Infrastructure.Salesforce.Domain.ValueString(),
 			ClientSecret: plan.Infrastructure.Salesforce.ClientSecret.ValueString(),
 		}
+
+		var clientSecretWO types.String
+		diags := config.GetAttribute(ctx, path.Root("infrastructure").AtName("salesforce").AtName("salesforce_client_secret_wo"), &clientSecretWO)
+		if diags.HasError() {
+			return fmt.Errorf("failed to read write-only Salesforce client secret: %v", diags)
+		}
+		if !clientSecretWO.IsNull() {
+			postConnReq.Infrastructure.Salesforce.ClientSecret = clientSecretWO.ValueString()
+		}
 	}
 
 	// Add terraform metadata
 	postConnReq.TerraformURL = plan.TerraformURL.ValueString()
-	postConnReq.TerraformModuleVersions = plan.TerraformModuleVersions.ValueString()
+	terraformModuleVersions, err := json.Marshal(plan.TerraformModuleVersions.toAPI())
+	if err != nil {
+		return fmt.Errorf("failed to encode terraform module versions: %v", err)
+	}
+	postConnReq.TerraformModuleVersions = string(terraformModuleVersions)
 
 	// Add deployed resource IDs
 	diags := plan.DeployedDatalakeIds.ElementsAs(ctx, &postConnReq.DeployedDatalakeIds, false)
@@ -270,12 +438,12 @@ func (r *postConnectionResource) executePostConnection(ctx context.Context, plan
 	}
 
 	// Execute post-connection process using the hosting environment ID and structured request
-	err := r.client.PostConnection(plan.TraceforceHostingEnvironmentId.ValueString(), postConnReq)
-
-	return err
+	return r.client.PostConnection(ctx, plan.TraceforceHostingEnvironmentId.ValueString(), postConnReq)
 }
 
-// Read refreshes the Terraform state with the latest data.
+// Read refreshes the status of the hosting environment the post-connection
+// applies to. The API does not expose the applied post-connection
+// configuration, so the configuration attributes keep their prior values.
 func (r *postConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state postConnectionResourceModel
 
@@ -286,10 +454,73 @@ func (r *postConnectionResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	// For this resource, we simply maintain the current state
-	// This allows Terraform to detect changes and trigger re-deployment
+	hostingEnvironmentID := state.TraceforceHostingEnvironmentId.ValueString()
+	hostingEnvironment, err := r.client.GetHostingEnvironment(ctx, hostingEnvironmentID)
+	if removeIfNotFound(ctx, err, resp, "hosting environment", hostingEnvironmentID) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error reading post-connection", err))
+		return
+	}
+
+	state.ID = types.StringValue(hostingEnvironment.ID)
+	state.Status = types.StringValue(string(hostingEnvironment.Status))
+
+	// delete_behavior only exists in Terraform, so it takes its default when
+	// the resource is imported.
+	if state.DeleteBehavior.IsNull() {
+		state.DeleteBehavior = types.StringValue(postConnectionDeleteAbandon)
+	}
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, postConnectionIdentityModel{TraceforceHostingEnvironmentId: state.TraceforceHostingEnvironmentId})...)
+}
+
+// toAPI converts the module versions to their JSON form.
+func (m *terraformModuleVersionsModel) toAPI() *apiModuleVersions {
+	if m == nil {
+		return nil
+	}
+
+	versions := &apiModuleVersions{
+		DatalakeConnectors: moduleVersionMapToAPI(m.DatalakeConnectors),
+		SourceConnectors:   moduleVersionMapToAPI(m.SourceConnectors),
+	}
+	if m.BaseInfrastructure != nil {
+		versions.BaseInfrastructure = &apiModuleVersion{
+			Major: m.BaseInfrastructure.Major.ValueInt64(),
+			Minor: m.BaseInfrastructure.Minor.ValueInt64(),
+		}
+	}
+	return versions
+}
+
+func moduleVersionMapToAPI(versions map[string]moduleVersionModel) map[string]apiModuleVersion {
+	if versions == nil {
+		return nil
+	}
+
+	result := make(map[string]apiModuleVersion, len(versions))
+	for name, version := range versions {
+		result[name] = apiModuleVersion{Major: version.Major.ValueInt64(), Minor: version.Minor.ValueInt64()}
+	}
+	return result
+}
+
+// moduleVersionAttributes returns the attributes of a module version.
+func moduleVersionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"major": schema.Int64Attribute{
+			Description: "Major version of the module",
+			Required:    true,
+		},
+		"minor": schema.Int64Attribute{
+			Description: "Minor version of the module",
+			Required:    true,
+		},
+	}
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -302,24 +533,239 @@ func (r *postConnectionResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	if err := r.executePostConnection(ctx, plan); err != nil {
-		resp.Diagnostics.AddError("Error executing post-connection", err.Error())
+	err := r.executePostConnection(ctx, plan, req.Config)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error executing post-connection", err))
+		return
+	}
+
+	err = r.setComputedAttributes(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error reading post-connection", err))
 		return
 	}
 
-	// Set state with the plan data
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, postConnectionIdentityModel{TraceforceHostingEnvironmentId: plan.TraceforceHostingEnvironmentId})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
-// Delete deletes the resource and removes the Terraform state on success.
+// Delete removes the post-connection from the Terraform state. The hosting
+// environment stays connected, as delete_behavior only accepts abandon until
+// the API provides a way to disconnect it.
 func (r *postConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This is a no-op for this resource.
 }
 
 func (r *postConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// This is a no-op for this resource.
+	// Import post-connection by hosting environment ID, given either as the
+	// import ID or as the identity. The API does not expose the applied
+	// configuration, so it is sent again by the next apply.
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("traceforce_hosting_environment_id"), path.Root("traceforce_hosting_environment_id"), req, resp)
+}
+
+// salesforceInfrastructureModelV0 maps salesforce infrastructure schema data
+// of schema version 0.
+type salesforceInfrastructureModelV0 struct {
+	ClientID     types.String `tfsdk:"salesforce_client_id"`
+	Domain       types.String `tfsdk:"salesforce_domain"`
+	ClientSecret types.String `tfsdk:"salesforce_client_secret"`
+}
+
+// infrastructureModelV0 maps infrastructure schema data of schema version 0.
+type infrastructureModelV0 struct {
+	Base       *baseInfrastructureModel         `tfsdk:"base"`
+	BigQuery   *bigqueryInfrastructureModel     `tfsdk:"bigquery"`
+	Salesforce *salesforceInfrastructureModelV0 `tfsdk:"salesforce"`
+}
+
+// postConnectionResourceModelV0 maps post_connection schema data of schema
+// version 0, where terraform_module_versions was a JSON string.
+type postConnectionResourceModelV0 struct {
+	TraceforceHostingEnvironmentId types.String           `tfsdk:"traceforce_hosting_environment_id"`
+	Infrastructure                 *infrastructureModelV0 `tfsdk:"infrastructure"`
+	TerraformURL                   types.String           `tfsdk:"terraform_url"`
+	TerraformModuleVersions        types.String           `tfsdk:"terraform_module_versions"`
+	DeployedDatalakeIds            types.List             `tfsdk:"deployed_datalake_ids"`
+	DeployedSourceAppIds           types.List             `tfsdk:"deployed_source_app_ids"`
+}
+
+// UpgradeState upgrades post-connection state from earlier schema versions.
+func (r *postConnectionResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	stringAttributes := func(names ...string) map[string]schema.Attribute {
+		attributes := make(map[string]schema.Attribute, len(names))
+		for _, name := range names {
+			attributes[name] = schema.StringAttribute{Optional: true}
+		}
+		return attributes
+	}
+
+	schemaV0 := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"traceforce_hosting_environment_id": schema.StringAttribute{Required: true},
+			"infrastructure": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"base": schema.SingleNestedAttribute{
+						Optional: true,
+						Attributes: stringAttributes("dataplane_identity_identifier", "workload_identity_provider_name",
+							"auth_view_generator_function_id", "auth_view_generator_function_url", "traceforce_bucket_name"),
+					},
+					"bigquery": schema.SingleNestedAttribute{
+						Optional:   true,
+						Attributes: stringAttributes("traceforce_schema", "traceforce_secure_views_schema", "events_subscription_name"),
+					},
+					"salesforce": schema.SingleNestedAttribute{
+						Optional:   true,
+						Attributes: stringAttributes("salesforce_client_id", "salesforce_domain", "salesforce_client_secret"),
+					},
+				},
+			},
+			"terraform_url":             schema.StringAttribute{Required: true},
+			"terraform_module_versions": schema.StringAttribute{Required: true},
+			"deployed_datalake_ids":     schema.ListAttribute{Required: true, ElementType: types.StringType},
+			"deployed_source_app_ids":   schema.ListAttribute{Required: true, ElementType: types.StringType},
+		},
+	}
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradePostConnectionStateV0,
+		},
+	}
+}
+
+// upgradePostConnectionStateV0 parses the terraform_module_versions JSON string
+// of schema version 0 into the nested attribute. Attributes added since then
+// are left null and filled in by the next refresh, except delete_behavior,
+// which is abandon to keep the earlier behaviour of only removing the
+// resource from the Terraform state.
+func upgradePostConnectionStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior postConnectionResourceModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Legacy values that cannot be parsed are dropped rather than failing the
+	// upgrade. The next apply sends the configured versions again.
+	terraformModuleVersions, err := parseTerraformModuleVersionsV0(prior.TerraformModuleVersions.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("terraform_module_versions"),
+			"Discarded post-connection terraform_module_versions",
+			fmt.Sprintf("Could not parse terraform_module_versions %q: %s. "+
+				"The value was removed from the state, and the next apply sends the configured versions.", prior.TerraformModuleVersions.ValueString(), err),
+		)
+	}
+
+	upgraded := postConnectionResourceModel{
+		ID:                             types.StringNull(),
+		TraceforceHostingEnvironmentId: prior.TraceforceHostingEnvironmentId,
+		TerraformURL:                   prior.TerraformURL,
+		TerraformModuleVersions:        terraformModuleVersions,
+		DeployedDatalakeIds:            prior.DeployedDatalakeIds,
+		DeployedSourceAppIds:           prior.DeployedSourceAppIds,
+		DeleteBehavior:                 types.StringValue(postConnectionDeleteAbandon),
+		Status:                         types.StringNull(),
+	}
+
+	if prior.Infrastructure != nil {
+		upgraded.Infrastructure = &infrastructureModel{
+			Base:     prior.Infrastructure.Base,
+			BigQuery: prior.Infrastructure.BigQuery,
+		}
+		if salesforce := prior.Infrastructure.Salesforce; salesforce != nil {
+			upgraded.Infrastructure.Salesforce = &salesforceInfrastructureModel{
+				ClientID:     salesforce.ClientID,
+				Domain:       salesforce.Domain,
+				ClientSecret: salesforce.ClientSecret,
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &upgraded)...)
+}
+
+// moduleVersionV0 is a module version in the terraform_module_versions JSON
+// string of schema version 0. Its fields are pointers so that missing keys can
+// be told apart from zero versions.
+type moduleVersionV0 struct {
+	Major *int64 `json:"major"`
+	Minor *int64 `json:"minor"`
+}
+
+// terraformModuleVersionsV0 is the terraform_module_versions JSON string of
+// schema version 0.
+type terraformModuleVersionsV0 struct {
+	BaseInfrastructure *moduleVersionV0           `json:"base_infrastructure"`
+	DatalakeConnectors map[string]moduleVersionV0 `json:"datalake_connectors"`
+	SourceConnectors   map[string]moduleVersionV0 `json:"source_connectors"`
+}
+
+// parseTerraformModuleVersionsV0 parses the terraform_module_versions JSON
+// string of schema version 0. Unknown keys and missing required keys are
+// rejected rather than silently dropped or defaulted to zero.
+func parseTerraformModuleVersionsV0(value string) (*terraformModuleVersionsModel, error) {
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.DisallowUnknownFields()
+
+	var versions terraformModuleVersionsV0
+	if err := decoder.Decode(&versions); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, errors.New("unexpected data after the JSON object")
+	}
+
+	if versions.BaseInfrastructure == nil {
+		return nil, errors.New(`missing required key "base_infrastructure"`)
+	}
+	baseInfrastructure, err := versions.BaseInfrastructure.toModel("base_infrastructure")
+	if err != nil {
+		return nil, err
+	}
+
+	model := &terraformModuleVersionsModel{BaseInfrastructure: &baseInfrastructure}
+	if model.DatalakeConnectors, err = moduleVersionMapV0ToModel("datalake_connectors", versions.DatalakeConnectors); err != nil {
+		return nil, err
+	}
+	if model.SourceConnectors, err = moduleVersionMapV0ToModel("source_connectors", versions.SourceConnectors); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+// toModel converts the module version at the given JSON key to its model.
+func (v moduleVersionV0) toModel(key string) (moduleVersionModel, error) {
+	if v.Major == nil {
+		return moduleVersionModel{}, fmt.Errorf("missing required key %q", key+".major")
+	}
+	if v.Minor == nil {
+		return moduleVersionModel{}, fmt.Errorf("missing required key %q", key+".minor")
+	}
+	return moduleVersionModel{Major: types.Int64Value(*v.Major), Minor: types.Int64Value(*v.Minor)}, nil
+}
+
+// moduleVersionMapV0ToModel converts the connector versions at the given JSON
+// key to their model. Omitted or empty maps are stored as null.
+func moduleVersionMapV0ToModel(key string, versions map[string]moduleVersionV0) (map[string]moduleVersionModel, error) {
+	if len(versions) == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]moduleVersionModel, len(versions))
+	for name, version := range versions {
+		model, err := version.toModel(key + "." + name)
+		if err != nil {
+			return nil, err
+		}
+		result[name] = model
+	}
+	return result, nil
 }
@@ -4,11 +4,21 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
 )
 
 func TestAccPostConnectionResource(t *testing.T) {
@@ -27,15 +37,16 @@ func TestAccPostConnectionResource(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "traceforce_hosting_environment_id", hostingEnvironmentId),
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
 					resource.TestCheckResourceAttrSet(resourceName, "status"),
-					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
-					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
 				),
 			},
 			// Import State testing
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    testAccPostConnectionImportStateID(resourceName),
+				ImportStateVerifyIdentifierAttribute: "traceforce_hosting_environment_id",
+				ImportStateVerifyIgnore:              postConnectionImportStateVerifyIgnore,
 			},
 		},
 	})
@@ -63,15 +74,16 @@ func TestAccPostConnectionResourceWithBigQuery(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "infrastructure.bigquery.events_subscription_name", eventsSubscription),
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
 					resource.TestCheckResourceAttrSet(resourceName, "status"),
-					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
-					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
 				),
 			},
 			// Import State testing
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    testAccPostConnectionImportStateID(resourceName),
+				ImportStateVerifyIdentifierAttribute: "traceforce_hosting_environment_id",
+				ImportStateVerifyIgnore:              postConnectionImportStateVerifyIgnore,
 			},
 		},
 	})
@@ -99,15 +111,16 @@ func TestAccPostConnectionResourceWithSalesforce(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "infrastructure.salesforce.salesforce_client_secret", secretMountPath),
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
 					resource.TestCheckResourceAttrSet(resourceName, "status"),
-					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
-					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
 				),
 			},
 			// Import State testing
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    testAccPostConnectionImportStateID(resourceName),
+				ImportStateVerifyIdentifierAttribute: "traceforce_hosting_environment_id",
+				ImportStateVerifyIgnore:              postConnectionImportStateVerifyIgnore,
 			},
 		},
 	})
@@ -141,15 +154,16 @@ func TestAccPostConnectionResourceWithBoth(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "infrastructure.salesforce.salesforce_client_secret", secretMountPath),
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
 					resource.TestCheckResourceAttrSet(resourceName, "status"),
-					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
-					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
 				),
 			},
 			// Import State testing
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    testAccPostConnectionImportStateID(resourceName),
+				ImportStateVerifyIdentifierAttribute: "traceforce_hosting_environment_id",
+				ImportStateVerifyIgnore:              postConnectionImportStateVerifyIgnore,
 			},
 		},
 	})
@@ -176,14 +190,17 @@ func TestAccPostConnectionResourceWithBase(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "infrastructure.base.auth_view_generator_function_url", "https://test-function-url.cloudfunctions.net/auth-view-generator"),
 					resource.TestCheckResourceAttr(resourceName, "infrastructure.base.traceforce_bucket_name", "test-traceforce-bucket"),
 					resource.TestCheckResourceAttrSet(resourceName, "terraform_url"),
-					resource.TestCheckResourceAttrSet(resourceName, "terraform_module_versions"),
+					resource.TestCheckResourceAttr(resourceName, "terraform_module_versions.base_infrastructure.major", "1"),
 				),
 			},
 			// Import State testing
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    testAccPostConnectionImportStateID(resourceName),
+				ImportStateVerifyIdentifierAttribute: "traceforce_hosting_environment_id",
+				ImportStateVerifyIgnore:              postConnectionImportStateVerifyIgnore,
 			},
 		},
 	})
@@ -202,7 +219,9 @@ resource "traceforce_post_connection" "test" {
   }
   
   terraform_url = "https://github.com/traceforce/terraform-modules"
-  terraform_module_versions = "{\"base\": \"v1.0.0\"}"
+  terraform_module_versions = {
+    base_infrastructure = { major = 1, minor = 0 }
+  }
   deployed_datalake_ids = []
   deployed_source_app_ids = []
 }
@@ -226,7 +245,12 @@ resource "traceforce_post_connection" "test" {
   }
   
   terraform_url = "https://github.com/traceforce/terraform-modules"
-  terraform_module_versions = "{\"bigquery\": \"v1.0.0\"}"
+  terraform_module_versions = {
+    base_infrastructure = { major = 1, minor = 0 }
+    datalake_connectors = {
+      bigquery = { major = 1, minor = 0 }
+    }
+  }
   deployed_datalake_ids = ["datalake-1"]
   deployed_source_app_ids = []
 }
@@ -250,7 +274,12 @@ resource "traceforce_post_connection" "test" {
   }
   
   terraform_url = "https://github.com/traceforce/terraform-modules"
-  terraform_module_versions = "{\"salesforce\": \"v1.0.0\"}"
+  terraform_module_versions = {
+    base_infrastructure = { major = 1, minor = 0 }
+    source_connectors = {
+      salesforce = { major = 1, minor = 0 }
+    }
+  }
   deployed_datalake_ids = []
   deployed_source_app_ids = ["source-app-1"]
 }
@@ -280,7 +309,15 @@ resource "traceforce_post_connection" "test" {
   }
   
   terraform_url = "https://github.com/traceforce/terraform-modules"
-  terraform_module_versions = "{\"bigquery\": \"v1.0.0\", \"salesforce\": \"v1.0.0\"}"
+  terraform_module_versions = {
+    base_infrastructure = { major = 1, minor = 0 }
+    datalake_connectors = {
+      bigquery = { major = 1, minor = 0 }
+    }
+    source_connectors = {
+      salesforce = { major = 1, minor = 0 }
+    }
+  }
   deployed_datalake_ids = ["datalake-1", "datalake-2"]
   deployed_source_app_ids = ["source-app-1", "source-app-2"]
 }
@@ -306,9 +343,471 @@ resource "traceforce_post_connection" "test" {
   }
   
   terraform_url = "https://github.com/traceforce/terraform-modules"
-  terraform_module_versions = "{\"base\": \"v1.0.0\"}"
+  terraform_module_versions = {
+    base_infrastructure = { major = 1, minor = 0 }
+  }
   deployed_datalake_ids = []
   deployed_source_app_ids = []
 }
 `, providerConfig, hostingEnvironmentId, dataplaneIdentifier)
 }
+
+func TestPostConnectionResourceRead(t *testing.T) {
+
+	testCases := map[string]struct {
+		state         func(projectID string) postConnectionResourceModel
+		deleteProject bool
+		expectRemoved bool
+	}{
+		"refresh": {
+			state: func(projectID string) postConnectionResourceModel {
+				return postConnectionResourceModel{
+					ID:                             types.StringValue(projectID),
+					TraceforceHostingEnvironmentId: types.StringValue(projectID),
+					Infrastructure: &infrastructureModel{
+						Salesforce: &salesforceInfrastructureModel{
+							ClientID:     types.StringValue("client-id"),
+							Domain:       types.StringValue("example.my.salesforce.com"),
+							ClientSecret: types.StringValue("projects/123/secrets/salesforce"),
+						},
+					},
+					TerraformURL: types.StringValue("https://github.com/traceforce/terraform-modules"),
+					TerraformModuleVersions: &terraformModuleVersionsModel{
+						BaseInfrastructure: &moduleVersionModel{Major: types.Int64Value(1), Minor: types.Int64Value(0)},
+					},
+					DeployedDatalakeIds:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("datalake-1")}),
+					DeployedSourceAppIds: types.ListValueMust(types.StringType, []attr.Value{}),
+					DeleteBehavior:       types.StringValue(postConnectionDeleteAbandon),
+					Status:               types.StringValue("pending"),
+				}
+			},
+		},
+		"import": {
+			state: func(projectID string) postConnectionResourceModel {
+				return postConnectionResourceModel{
+					TraceforceHostingEnvironmentId: types.StringValue(projectID),
+					DeployedDatalakeIds:            types.ListNull(types.StringType),
+					DeployedSourceAppIds:           types.ListNull(types.StringType),
+				}
+			},
+		},
+		"project-deleted": {
+			state: func(projectID string) postConnectionResourceModel {
+				return postConnectionResourceModel{
+					TraceforceHostingEnvironmentId: types.StringValue(projectID),
+					DeployedDatalakeIds:            types.ListNull(types.StringType),
+					DeployedSourceAppIds:           types.ListNull(types.StringType),
+				}
+			},
+			deleteProject: true,
+			expectRemoved: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+			f := newFakeAPI(t)
+			projectID := f.seed(t, "production").projectID
+			if testCase.deleteProject {
+				if err := f.client().DeleteHostingEnvironment(ctx, projectID); err != nil {
+					t.Fatalf("unexpected error deleting project: %v", err)
+				}
+			}
+
+			prior := testCase.state(projectID)
+			resp := f.readResource(t, NewPostConnectionResource(), &prior)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+
+			if testCase.expectRemoved {
+				if !resp.State.Raw.IsNull() {
+					t.Errorf("expected the post-connection to be removed from state, got: %v", resp.State.Raw)
+				}
+				return
+			}
+
+			var got postConnectionResourceModel
+			if diags := resp.State.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("unexpected state diagnostics: %v", diags)
+			}
+
+			if got.ID.ValueString() != projectID {
+				t.Errorf("expected id %q, got %q", projectID, got.ID.ValueString())
+			}
+			if got.Status.ValueString() != string(traceforce.HostingEnvironmentStatusPending) {
+				t.Errorf("expected status %q, got %q", traceforce.HostingEnvironmentStatusPending, got.Status.ValueString())
+			}
+
+			// The configuration is not exposed by the API, so it is kept.
+			expected := prior
+			expected.ID = got.ID
+			expected.Status = got.Status
+			if expected.DeleteBehavior.IsNull() {
+				expected.DeleteBehavior = types.StringValue(postConnectionDeleteAbandon)
+			}
+			if !reflect.DeepEqual(got, expected) {
+				t.Errorf("expected the prior configuration to be kept\nexpected: %+v\ngot:      %+v", expected, got)
+			}
+		})
+	}
+}
+
+func TestPostConnectionResourceCreateWriteOnlySecret(t *testing.T) {
+	ctx := t.Context()
+	f := newFakeAPI(t)
+	hostingEnvironmentID := f.seed(t, "production").projectID
+	secret := "projects/123/secrets/salesforce/versions/2"
+
+	r := &postConnectionResource{client: f.client()}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	model := postConnectionResourceModel{
+		ID:                             types.StringUnknown(),
+		TraceforceHostingEnvironmentId: types.StringValue(hostingEnvironmentID),
+		Infrastructure: &infrastructureModel{
+			Salesforce: &salesforceInfrastructureModel{
+				ClientID:              types.StringValue("client-id"),
+				Domain:                types.StringValue("example.my.salesforce.com"),
+				ClientSecret:          types.StringNull(),
+				ClientSecretWO:        types.StringValue(secret),
+				ClientSecretWOVersion: types.Int64Value(2),
+			},
+		},
+		TerraformURL: types.StringValue("https://github.com/traceforce/terraform-modules"),
+		TerraformModuleVersions: &terraformModuleVersionsModel{
+			BaseInfrastructure: &moduleVersionModel{Major: types.Int64Value(1), Minor: types.Int64Value(0)},
+		},
+		DeployedDatalakeIds:  types.ListValueMust(types.StringType, []attr.Value{}),
+		DeployedSourceAppIds: types.ListValueMust(types.StringType, []attr.Value{}),
+		DeleteBehavior:       types.StringValue(postConnectionDeleteAbandon),
+		Status:               types.StringUnknown(),
+	}
+
+	// Build the configuration through a state, which supports setting a whole
+	// model. Write-only values are only present in the configuration.
+	configState := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := configState.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("unexpected config diagnostics: %v", diags)
+	}
+
+	model.Infrastructure.Salesforce.ClientSecretWO = types.StringNull()
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("unexpected plan diagnostics: %v", diags)
+	}
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, fwresource.CreateRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw},
+		Plan:   plan,
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+	}
+
+	var sent struct {
+		Infrastructure traceforce.Infrastructure `json:"infrastructure"`
+	}
+	if err := json.Unmarshal(f.postConnections[hostingEnvironmentID], &sent); err != nil || sent.Infrastructure.Salesforce == nil {
+		t.Fatalf("expected a post-connection with salesforce infrastructure, got %s: %v", f.postConnections[hostingEnvironmentID], err)
+	}
+	if sent.Infrastructure.Salesforce.ClientSecret != secret {
+		t.Errorf("expected write-only secret %q to be sent, got %q", secret, sent.Infrastructure.Salesforce.ClientSecret)
+	}
+
+	var state postConnectionResourceModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("unexpected state diagnostics: %v", diags)
+	}
+	if !state.Infrastructure.Salesforce.ClientSecretWO.IsNull() || !state.Infrastructure.Salesforce.ClientSecret.IsNull() {
+		t.Errorf("expected no client secret in state, got: %+v", state.Infrastructure.Salesforce)
+	}
+	if state.Infrastructure.Salesforce.ClientSecretWOVersion.ValueInt64() != 2 {
+		t.Errorf("expected salesforce_client_secret_wo_version 2, got %s", state.Infrastructure.Salesforce.ClientSecretWOVersion)
+	}
+
+	// The API answers with an empty body, so id and status are read from the
+	// hosting environment.
+	if state.ID.ValueString() != hostingEnvironmentID {
+		t.Errorf("expected id %q, got %q", hostingEnvironmentID, state.ID.ValueString())
+	}
+	if state.Status.ValueString() != string(traceforce.HostingEnvironmentStatusConnected) {
+		t.Errorf("expected status %q, got %q", traceforce.HostingEnvironmentStatusConnected, state.Status.ValueString())
+	}
+}
+
+func TestPostConnectionResourceDelete(t *testing.T) {
+	f := newFakeAPI(t)
+	hostingEnvironmentID := f.seed(t, "production").projectID
+
+	resp := f.deleteResource(t, NewPostConnectionResource(), &postConnectionResourceModel{
+		TraceforceHostingEnvironmentId: types.StringValue(hostingEnvironmentID),
+		DeployedDatalakeIds:            types.ListNull(types.StringType),
+		DeployedSourceAppIds:           types.ListNull(types.StringType),
+		DeleteBehavior:                 types.StringValue(postConnectionDeleteAbandon),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	// Abandoning the post-connection leaves the hosting environment as it is.
+	if _, err := f.client().GetHostingEnvironment(t.Context(), hostingEnvironmentID); err != nil {
+		t.Errorf("expected the hosting environment to be kept, got %v", err)
+	}
+}
+
+func TestPostConnectionResourceUpgradeStateV0(t *testing.T) {
+	testCases := map[string]struct {
+		terraformModuleVersions string
+		expectWarning           bool
+	}{
+		"structured-json": {
+			terraformModuleVersions: `{
+				"base_infrastructure": {"major": 1, "minor": 3},
+				"datalake_connectors": {"bigquery": {"major": 2, "minor": 0}}
+			}`,
+		},
+		"invalid-json": {
+			terraformModuleVersions: `{"base_infrastructure": `,
+			expectWarning:           true,
+		},
+		"unknown-key": {
+			terraformModuleVersions: `{"base_infrastructure": {"major": 1, "minor": 0}, "datalake_connector": {"bigquery": {"major": 2, "minor": 0}}}`,
+			expectWarning:           true,
+		},
+		"unknown-version-key": {
+			terraformModuleVersions: `{"base_infrastructure": {"major": 1, "minor": 0, "patch": 2}}`,
+			expectWarning:           true,
+		},
+		"missing-base-infrastructure": {
+			terraformModuleVersions: `{"datalake_connectors": {"bigquery": {"major": 2, "minor": 0}}}`,
+			expectWarning:           true,
+		},
+		"missing-minor": {
+			terraformModuleVersions: `{"base_infrastructure": {"major": 1}}`,
+			expectWarning:           true,
+		},
+		"missing-connector-major": {
+			terraformModuleVersions: `{"base_infrastructure": {"major": 1, "minor": 0}, "source_connectors": {"salesforce": {"minor": 0}}}`,
+			expectWarning:           true,
+		},
+		"legacy-format": {
+			terraformModuleVersions: `{"base": "v1.0.0"}`,
+			expectWarning:           true,
+		},
+		"trailing-data": {
+			terraformModuleVersions: `{"base_infrastructure": {"major": 1, "minor": 0}} {}`,
+			expectWarning:           true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+			r := &postConnectionResource{}
+
+			upgrader, ok := r.UpgradeState(ctx)[0]
+			if !ok {
+				t.Fatal("expected a state upgrader from schema version 0")
+			}
+
+			priorState := tfsdk.State{Schema: *upgrader.PriorSchema}
+			diags := priorState.Set(ctx, &postConnectionResourceModelV0{
+				TraceforceHostingEnvironmentId: types.StringValue(uuid.New().String()),
+				Infrastructure: &infrastructureModelV0{
+					Salesforce: &salesforceInfrastructureModelV0{
+						ClientID:     types.StringValue("client-id"),
+						Domain:       types.StringValue("example.my.salesforce.com"),
+						ClientSecret: types.StringValue("projects/123/secrets/salesforce"),
+					},
+				},
+				TerraformURL:            types.StringValue("https://github.com/traceforce/terraform-modules"),
+				TerraformModuleVersions: types.StringValue(testCase.terraformModuleVersions),
+				DeployedDatalakeIds:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("datalake-1")}),
+				DeployedSourceAppIds:    types.ListValueMust(types.StringType, []attr.Value{}),
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected prior state diagnostics: %v", diags)
+			}
+
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+			resp := &fwresource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			upgrader.StateUpgrader(ctx, fwresource.UpgradeStateRequest{State: &priorState}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if testCase.expectWarning != (resp.Diagnostics.WarningsCount() > 0) {
+				t.Fatalf("expected warning: %t, got diagnostics: %v", testCase.expectWarning, resp.Diagnostics)
+			}
+
+			var state postConnectionResourceModel
+			if diags := resp.State.Get(ctx, &state); diags.HasError() {
+				t.Fatalf("unexpected state diagnostics: %v", diags)
+			}
+			if state.Infrastructure.Salesforce.ClientSecret.ValueString() != "projects/123/secrets/salesforce" {
+				t.Errorf("expected salesforce_client_secret to be kept, got: %s", state.Infrastructure.Salesforce.ClientSecret)
+			}
+			if state.DeleteBehavior.ValueString() != postConnectionDeleteAbandon {
+				t.Errorf("expected delete_behavior %q, got %s", postConnectionDeleteAbandon, state.DeleteBehavior)
+			}
+
+			if testCase.expectWarning {
+				warnings := resp.Diagnostics.Warnings()
+				withPath, ok := warnings[0].(diag.DiagnosticWithPath)
+				if len(warnings) != 1 || !ok || !withPath.Path().Equal(path.Root("terraform_module_versions")) {
+					t.Errorf("expected a warning on terraform_module_versions, got: %v", resp.Diagnostics)
+				}
+				if state.TerraformModuleVersions != nil {
+					t.Errorf("expected terraform_module_versions to be null, got: %+v", state.TerraformModuleVersions)
+				}
+				return
+			}
+
+			versions := state.TerraformModuleVersions
+			if versions == nil || versions.BaseInfrastructure == nil || versions.BaseInfrastructure.Minor.ValueInt64() != 3 {
+				t.Fatalf("expected base_infrastructure version 1.3, got: %+v", versions)
+			}
+			if bigquery, ok := versions.DatalakeConnectors["bigquery"]; !ok || bigquery.Major.ValueInt64() != 2 {
+				t.Errorf("expected bigquery connector version 2.0, got: %+v", versions.DatalakeConnectors)
+			}
+		})
+	}
+}
+
+// postConnectionImportStateVerifyIgnore lists the attributes an imported
+// post-connection does not have. The API does not expose the applied
+// configuration, so only the hosting environment is imported.
+var postConnectionImportStateVerifyIgnore = []string{
+	"infrastructure",
+	"terraform_url",
+	"terraform_module_versions",
+	"deployed_datalake_ids",
+	"deployed_source_app_ids",
+}
+
+// testAccPostConnectionImportStateID returns the ID a post-connection is
+// imported by, which is the ID of its hosting environment.
+func testAccPostConnectionImportStateID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["traceforce_hosting_environment_id"], nil
+	}
+}
+
+func TestUnitPostConnectionResource(t *testing.T) {
+	f := newFakeAPI(t)
+
+	config := func(minorVersion int) string {
+		return f.providerConfig() + fmt.Sprintf(`
+resource "traceforce_project" "test" {
+  name           = "production"
+  type           = "customer_managed"
+  cloud_provider = "gcp"
+  native_id      = "my-gcp-project"
+}
+
+resource "traceforce_post_connection" "test" {
+  traceforce_hosting_environment_id = traceforce_project.test.id
+
+  infrastructure = {
+    base = {
+      dataplane_identity_identifier    = "dataplane-identity"
+      workload_identity_provider_name  = "projects/123/locations/global/workloadIdentityPools/test-pool/providers/test-provider"
+      auth_view_generator_function_id  = "auth-view-generator"
+      auth_view_generator_function_url = "https://test-function-url.cloudfunctions.net/auth-view-generator"
+      traceforce_bucket_name           = "traceforce-bucket"
+    }
+
+    bigquery = {
+      traceforce_schema              = "traceforce_dataset"
+      traceforce_secure_views_schema = "traceforce_secure_views_dataset"
+      events_subscription_name       = "events-subscription"
+    }
+
+    salesforce = {
+      salesforce_client_id     = "client-id"
+      salesforce_domain        = "example.my.salesforce.com"
+      salesforce_client_secret = "projects/test-project/secrets/salesforce-secret/versions/latest"
+    }
+  }
+
+  terraform_url = "https://github.com/traceforce/terraform-modules"
+  terraform_module_versions = {
+    base_infrastructure = { major = 1, minor = %d }
+    datalake_connectors = {
+      bigquery = { major = 1, minor = 0 }
+    }
+  }
+  deployed_datalake_ids   = []
+  deployed_source_app_ids = []
+}
+`, minorVersion)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             f.checkDestroyed,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config(0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("traceforce_post_connection.test", "traceforce_hosting_environment_id", "traceforce_project.test", "id"),
+					resource.TestCheckResourceAttr("traceforce_post_connection.test", "infrastructure.base.traceforce_bucket_name", "traceforce-bucket"),
+					resource.TestCheckResourceAttr("traceforce_post_connection.test", "infrastructure.bigquery.traceforce_schema", "traceforce_dataset"),
+					resource.TestCheckResourceAttr("traceforce_post_connection.test", "infrastructure.salesforce.salesforce_domain", "example.my.salesforce.com"),
+					resource.TestCheckResourceAttr("traceforce_post_connection.test", "terraform_module_versions.base_infrastructure.minor", "0"),
+					resource.TestCheckResourceAttr("traceforce_post_connection.test", "terraform_module_versions.datalake_connectors.bigquery.major", "1"),
+					resource.TestCheckNoResourceAttr("traceforce_post_connection.test", "terraform_module_versions.source_connectors"),
+					resource.TestCheckResourceAttr("traceforce_post_connection.test", "delete_behavior", postConnectionDeleteAbandon),
+					resource.TestCheckResourceAttr("traceforce_post_connection.test", "status", string(traceforce.HostingEnvironmentStatusConnected)),
+					resource.TestCheckResourceAttrSet("traceforce_post_connection.test", "id"),
+				),
+			},
+			// Import State testing
+			{
+				ResourceName:                         "traceforce_post_connection.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    testAccPostConnectionImportStateID("traceforce_post_connection.test"),
+				ImportStateVerifyIdentifierAttribute: "traceforce_hosting_environment_id",
+				ImportStateVerifyIgnore:              postConnectionImportStateVerifyIgnore,
+			},
+			// Update and Read testing
+			{
+				Config: config(1),
+				Check:  resource.TestCheckResourceAttr("traceforce_post_connection.test", "terraform_module_versions.base_infrastructure.minor", "1"),
+			},
+			// A post-connection whose hosting environment was deleted is
+			// removed from state
+			{
+				PreConfig: func() {
+					projects, err := f.client().GetHostingEnvironments(t.Context())
+					if err != nil || len(projects) != 1 {
+						t.Fatalf("unexpected projects %v: %v", projects, err)
+					}
+					if err := f.client().DeleteHostingEnvironment(t.Context(), projects[0].ID); err != nil {
+						t.Fatalf("unexpected error deleting project: %v", err)
+					}
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config(1),
+				Check:  resource.TestCheckResourceAttrSet("traceforce_post_connection.test", "id"),
+			},
+		},
+	})
+}
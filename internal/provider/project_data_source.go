@@ -0,0 +1,186 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &projectDataSource{}
+	_ datasource.DataSourceWithConfigure        = &projectDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &projectDataSource{}
+)
+
+func NewProjectDataSource() datasource.DataSource {
+	return &projectDataSource{}
+}
+
+// projectDataSource looks up a single project by ID, name or native ID.
+type projectDataSource struct {
+	client *apiClient
+}
+
+func (d *projectDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *projectDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single project by id, name, or cloud_provider and native_id.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "System generated ID of the project. Exactly one of id, name or native_id must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the project. Exactly one of id, name or native_id must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"cloud_provider": schema.StringAttribute{
+				Description: fmt.Sprintf("Cloud provider for the project. Required when looking up by native_id. Valid values: %s, %s, %s.",
+					traceforce.CloudProviderAWS,
+					traceforce.CloudProviderGCP,
+					traceforce.CloudProviderAzure),
+				Optional: true,
+				Computed: true,
+			},
+			"native_id": schema.StringAttribute{
+				Description: "Native ID of the cloud project. For example, an AWS account ID, an Azure subscription ID, a GCP project ID, etc. " +
+					"Exactly one of id, name or native_id must be set.",
+				Optional: true,
+				Computed: true,
+			},
+			"type": schema.StringAttribute{
+				Description: fmt.Sprintf("Type of project. Valid values: %s, %s.",
+					traceforce.HostingEnvironmentTypeCustomerManaged,
+					traceforce.HostingEnvironmentTypeTraceForceManaged),
+				Computed: true,
+			},
+			"status": schema.StringAttribute{
+				Description: fmt.Sprintf("Status of the project. Valid values: %s, %s, %s.",
+					traceforce.HostingEnvironmentStatusPending,
+					traceforce.HostingEnvironmentStatusDisconnected,
+					traceforce.HostingEnvironmentStatusConnected),
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Date and time the project was created",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Date and time the project was last updated",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *projectDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("native_id"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("cloud_provider"),
+			path.MatchRoot("native_id"),
+		),
+	}
+}
+
+func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config projectsModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var project *traceforce.HostingEnvironment
+	if !config.ID.IsNull() {
+		hostingEnvironment, err := d.client.GetHostingEnvironment(ctx, config.ID.ValueString())
+		if isNotFound(err) {
+			resp.Diagnostics.AddError("No project found", fmt.Sprintf("No project has id %q.", config.ID.ValueString()))
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic("Error reading hosting environment", err))
+			return
+		}
+		project = hostingEnvironment
+	} else {
+		hostingEnvironments, err := d.client.GetHostingEnvironments(ctx)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic("Error reading hosting environments", err))
+			return
+		}
+
+		description := fmt.Sprintf("cloud_provider %q and native_id %q", config.CloudProvider.ValueString(), config.NativeId.ValueString())
+		matchesConfig := func(env traceforce.HostingEnvironment) bool {
+			return string(env.CloudProvider) == config.CloudProvider.ValueString() && env.NativeID == config.NativeId.ValueString()
+		}
+		if !config.Name.IsNull() {
+			description = fmt.Sprintf("name %q", config.Name.ValueString())
+			matchesConfig = func(env traceforce.HostingEnvironment) bool {
+				return env.Name == config.Name.ValueString()
+			}
+		}
+
+		var matches []traceforce.HostingEnvironment
+		for _, env := range hostingEnvironments {
+			if matchesConfig(env) {
+				matches = append(matches, env)
+			}
+		}
+
+		match, ok := lookupOne(matches, func(env traceforce.HostingEnvironment) string { return env.ID }, "project", description, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		project = &match
+	}
+
+	// Set state
+	state := projectsModelFromAPI(project)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
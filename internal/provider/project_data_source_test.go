@@ -0,0 +1,128 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+func TestAccProjectDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "traceforce_project" "by_name" {
+  name = "test-project-1"
+}
+
+data "traceforce_project" "by_native_id" {
+  cloud_provider = "gcp"
+  native_id      = "test-project-1"
+}
+
+data "traceforce_project" "by_id" {
+  id = data.traceforce_project.by_name.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.traceforce_project.by_name", "id"),
+					resource.TestCheckResourceAttr("data.traceforce_project.by_name", "type", "customer_managed"),
+					resource.TestCheckResourceAttr("data.traceforce_project.by_name", "cloud_provider", "gcp"),
+					resource.TestCheckResourceAttr("data.traceforce_project.by_name", "native_id", "test-project-1"),
+					resource.TestCheckResourceAttrPair("data.traceforce_project.by_native_id", "id", "data.traceforce_project.by_name", "id"),
+					resource.TestCheckResourceAttr("data.traceforce_project.by_id", "name", "test-project-1"),
+				),
+			},
+		},
+	})
+}
+
+func TestProjectDataSourceRead(t *testing.T) {
+	f := newFakeAPI(t)
+	projects := []traceforce.HostingEnvironment{
+		{ID: uuid.New().String(), Name: "analytics", CloudProvider: traceforce.CloudProviderGCP, NativeID: "analytics-prod"},
+		{ID: uuid.New().String(), Name: "shared", CloudProvider: traceforce.CloudProviderAWS, NativeID: "123456789012"},
+		{ID: uuid.New().String(), Name: "shared", CloudProvider: traceforce.CloudProviderAWS, NativeID: "210987654321"},
+	}
+	for _, project := range projects {
+		f.add(project)
+	}
+
+	testLookup(t, f, NewProjectDataSource, map[string]lookupTestCase[projectsModel]{
+		"by-id": {
+			config:     projectsModel{ID: types.StringValue(projects[1].ID)},
+			expectedID: projects[1].ID,
+		},
+		"by-name": {
+			config:     projectsModel{Name: types.StringValue("analytics")},
+			expectedID: projects[0].ID,
+		},
+		"by-native-id": {
+			config:     projectsModel{CloudProvider: types.StringValue("aws"), NativeId: types.StringValue("210987654321")},
+			expectedID: projects[2].ID,
+		},
+		"unknown-id": {
+			config:      projectsModel{ID: types.StringValue(missingID)},
+			expectError: "No project found",
+		},
+		"no-match": {
+			config:      projectsModel{CloudProvider: types.StringValue("gcp"), NativeId: types.StringValue("123456789012")},
+			expectError: "No project found",
+		},
+		"multiple-matches": {
+			config:      projectsModel{Name: types.StringValue("shared")},
+			expectError: "Multiple projects found",
+		},
+	}, func(t *testing.T, state projectsModel, expectedID string) {
+		if state.ID.ValueString() != expectedID {
+			t.Errorf("expected project %q, got %q", expectedID, state.ID.ValueString())
+		}
+	})
+}
+func TestUnitProjectDataSource(t *testing.T) {
+	f := newFakeAPI(t)
+	account := f.seed(t, "production")
+	f.seed(t, "staging")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: f.providerConfig() + `
+data "traceforce_project" "by_id" {
+  id = "` + account.projectID + `"
+}
+
+data "traceforce_project" "by_name" {
+  name = "production"
+}
+
+data "traceforce_project" "by_native_id" {
+  cloud_provider = "gcp"
+  native_id      = "production"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.traceforce_project.by_id", "name", "production"),
+					resource.TestCheckResourceAttr("data.traceforce_project.by_id", "type", "customer_managed"),
+					resource.TestCheckResourceAttr("data.traceforce_project.by_id", "cloud_provider", "gcp"),
+					resource.TestCheckResourceAttr("data.traceforce_project.by_id", "native_id", "production"),
+					resource.TestCheckResourceAttr("data.traceforce_project.by_id", "status", "pending"),
+					resource.TestCheckResourceAttrSet("data.traceforce_project.by_id", "created_at"),
+					resource.TestCheckResourceAttr("data.traceforce_project.by_name", "id", account.projectID),
+					resource.TestCheckResourceAttr("data.traceforce_project.by_native_id", "id", account.projectID),
+				),
+			},
+		},
+	})
+}
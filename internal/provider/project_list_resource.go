@@ -0,0 +1,98 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &projectListResource{}
+	_ list.ListResourceWithConfigure = &projectListResource{}
+)
+
+// NewProjectListResource creates a new project list resource.
+func NewProjectListResource() list.ListResource {
+	return &projectListResource{}
+}
+
+// projectListResource lists existing projects so that terraform query can
+// generate configuration and import blocks for them. It shares its metadata
+// and configuration with the project resource.
+type projectListResource struct {
+	projectResource
+}
+
+// projectListModel maps the project list configuration.
+type projectListModel struct {
+	CloudProvider types.String `tfsdk:"cloud_provider"`
+}
+
+// ListResourceConfigSchema defines the schema for list blocks.
+func (r *projectListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the projects of the account.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_provider": schema.StringAttribute{
+				Description: fmt.Sprintf("Only list projects on this cloud provider. Valid values: %s, %s, %s.",
+					traceforce.CloudProviderAWS,
+					traceforce.CloudProviderGCP,
+					traceforce.CloudProviderAzure),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(stringValues(traceforce.CloudProviderAWS, traceforce.CloudProviderGCP, traceforce.CloudProviderAzure)...),
+				},
+			},
+		},
+	}
+}
+
+// List streams the projects matching the list configuration.
+func (r *projectListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config projectListModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	hostingEnvironments, err := r.client.GetHostingEnvironments(ctx)
+	if err != nil {
+		stream.Results = listError("Error reading hosting environments", err)
+		return
+	}
+
+	var projects []traceforce.HostingEnvironment
+	for _, env := range hostingEnvironments {
+		if !config.CloudProvider.IsNull() && string(env.CloudProvider) != config.CloudProvider.ValueString() {
+			continue
+		}
+		projects = append(projects, env)
+	}
+
+	stream.Results = listResults(ctx, req, projects, func(project traceforce.HostingEnvironment) (string, any, any) {
+		return project.Name, idIdentityModel{ID: types.StringValue(project.ID)}, projectResourceModel{
+			ID:            types.StringValue(project.ID),
+			Name:          types.StringValue(project.Name),
+			Type:          types.StringValue(string(project.Type)),
+			CloudProvider: types.StringValue(string(project.CloudProvider)),
+			NativeId:      types.StringValue(project.NativeID),
+			Status:        types.StringValue(string(project.Status)),
+			CreatedAt:     types.StringValue(project.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt:     types.StringValue(project.UpdatedAt.Format(time.RFC3339)),
+			Timeouts:      nullTimeouts("create", "update"),
+		}
+	})
+}
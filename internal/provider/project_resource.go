@@ -6,22 +6,32 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	traceforce "github.com/traceforce/traceforce-go-sdk"
 )
 
+// defaultProjectTimeout bounds how long Create and Update wait for a project
+// to reach wait_for_status when no timeout is configured.
+const defaultProjectTimeout = 20 * time.Minute
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &projectResource{}
-	_ resource.ResourceWithConfigure   = &projectResource{}
-	_ resource.ResourceWithImportState = &projectResource{}
+	_ resource.Resource                     = &projectResource{}
+	_ resource.ResourceWithConfigure        = &projectResource{}
+	_ resource.ResourceWithImportState      = &projectResource{}
+	_ resource.ResourceWithIdentity         = &projectResource{}
+	_ resource.ResourceWithConfigValidators = &projectResource{}
 )
 
 // NewProjectResource creates a new project resource.
@@ -31,19 +41,21 @@ func NewProjectResource() resource.Resource {
 
 // projectResource is the resource implementation.
 type projectResource struct {
-	client *traceforce.Client
+	client *apiClient
 }
 
 // projectResourceModel maps projects schema data.
 type projectResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	Type          types.String `tfsdk:"type"`
-	CloudProvider types.String `tfsdk:"cloud_provider"`
-	NativeId      types.String `tfsdk:"native_id"`
-	Status        types.String `tfsdk:"status"`
-	CreatedAt     types.String `tfsdk:"created_at"`
-	UpdatedAt     types.String `tfsdk:"updated_at"`
+	ID            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	Type          types.String   `tfsdk:"type"`
+	CloudProvider types.String   `tfsdk:"cloud_provider"`
+	NativeId      types.String   `tfsdk:"native_id"`
+	Status        types.String   `tfsdk:"status"`
+	CreatedAt     types.String   `tfsdk:"created_at"`
+	UpdatedAt     types.String   `tfsdk:"updated_at"`
+	WaitForStatus types.String   `tfsdk:"wait_for_status"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -58,7 +70,7 @@ func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*traceforce.Client)
+	client, ok := req.ProviderData.(*apiClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
@@ -73,7 +85,7 @@ func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureR
 }
 
 // Schema defines the schema for the resource.
-func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *projectResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -85,6 +97,11 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					traceforce.HostingEnvironmentTypeCustomerManaged,
 					traceforce.HostingEnvironmentTypeTraceForceManaged),
 				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(stringValues(
+						traceforce.HostingEnvironmentTypeCustomerManaged,
+						traceforce.HostingEnvironmentTypeTraceForceManaged)...),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -95,6 +112,12 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					traceforce.CloudProviderGCP,
 					traceforce.CloudProviderAzure),
 				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(stringValues(
+						traceforce.CloudProviderAWS,
+						traceforce.CloudProviderGCP,
+						traceforce.CloudProviderAzure)...),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -106,6 +129,16 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"wait_for_status": schema.StringAttribute{
+				Description: fmt.Sprintf("Status to wait for after the project is created or updated. The only supported value is %s. "+
+					"The apply fails if the project becomes %s instead. If not set, the provider does not wait.",
+					traceforce.HostingEnvironmentStatusConnected,
+					traceforce.HostingEnvironmentStatusDisconnected),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(traceforce.HostingEnvironmentStatusConnected)),
+				},
+			},
 			// The following attributes are computed and should never be reflected in changes.
 			"status": schema.StringAttribute{
 				Description: fmt.Sprintf("Status of the project. Valid values: %s, %s, %s.",
@@ -139,9 +172,27 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
 	}
 }
 
+// ConfigValidators returns the resource-level validators of the project.
+func (r *projectResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		projectNativeIDValidator{},
+	}
+}
+
+// IdentitySchema defines the identity schema for the resource.
+func (r *projectResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("project")
+}
+
 func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan projectResourceModel
 
@@ -158,12 +209,26 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		NativeID:      plan.NativeId.ValueString(),
 	}
 
-	project, err := r.client.CreateHostingEnvironment(input)
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultProjectTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := r.client.CreateHostingEnvironment(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating hosting environment", err.Error())
+		resp.Diagnostics.Append(apiNameErrorDiagnostic("Error creating hosting environment", err))
 		return
 	}
 
+	if !plan.WaitForStatus.IsNull() {
+		project, err = r.waitForStatus(ctx, project, plan.WaitForStatus.ValueString(), createTimeout)
+		if err != nil {
+			// Keep the created project in state so it is tainted rather than orphaned.
+			resp.Diagnostics.Append(apiErrorDiagnostic("Error waiting for hosting environment status", err))
+		}
+	}
+
 	plan = projectResourceModel{
 		ID:            types.StringValue(project.ID),
 		Name:          types.StringValue(project.Name),
@@ -173,10 +238,13 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		Status:        types.StringValue(string(project.Status)),
 		CreatedAt:     types.StringValue(project.CreatedAt.Format(time.RFC3339)),
 		UpdatedAt:     types.StringValue(project.UpdatedAt.Format(time.RFC3339)),
+		WaitForStatus: plan.WaitForStatus,
+		Timeouts:      plan.Timeouts,
 	}
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentityModel{ID: plan.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -192,9 +260,12 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	project, err := r.client.GetHostingEnvironment(state.ID.ValueString())
+	project, err := r.client.GetHostingEnvironment(ctx, state.ID.ValueString())
+	if removeIfNotFound(ctx, err, resp, "hosting environment", state.ID.ValueString()) {
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading hosting environment", err.Error())
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error reading hosting environment", err))
 		return
 	}
 
@@ -207,10 +278,13 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		Status:        types.StringValue(string(project.Status)),
 		CreatedAt:     types.StringValue(project.CreatedAt.Format(time.RFC3339)),
 		UpdatedAt:     types.StringValue(project.UpdatedAt.Format(time.RFC3339)),
+		WaitForStatus: state.WaitForStatus,
+		Timeouts:      state.Timeouts,
 	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentityModel{ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -231,12 +305,25 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		Name: &name,
 	}
 
-	project, err := r.client.UpdateHostingEnvironment(plan.ID.ValueString(), input)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultProjectTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := r.client.UpdateHostingEnvironment(ctx, plan.ID.ValueString(), input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating hosting environment", err.Error())
+		resp.Diagnostics.Append(apiNameErrorDiagnostic("Error updating hosting environment", err))
 		return
 	}
 
+	if !plan.WaitForStatus.IsNull() {
+		project, err = r.waitForStatus(ctx, project, plan.WaitForStatus.ValueString(), updateTimeout)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic("Error waiting for hosting environment status", err))
+		}
+	}
+
 	plan = projectResourceModel{
 		ID:            types.StringValue(project.ID),
 		Name:          types.StringValue(project.Name),
@@ -246,10 +333,13 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		Status:        types.StringValue(string(project.Status)),
 		CreatedAt:     types.StringValue(project.CreatedAt.Format(time.RFC3339)),
 		UpdatedAt:     types.StringValue(project.UpdatedAt.Format(time.RFC3339)),
+		WaitForStatus: plan.WaitForStatus,
+		Timeouts:      plan.Timeouts,
 	}
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentityModel{ID: plan.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -264,14 +354,88 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	err := r.client.DeleteHostingEnvironment(state.ID.ValueString())
+	err := r.client.DeleteHostingEnvironment(ctx, state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting hosting environment", err.Error())
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error deleting hosting environment", err))
 		return
 	}
 }
 
+// waitForStatus polls the project until it reaches the target status, it
+// becomes disconnected, or the timeout expires. The latest known project is
+// returned even when waiting fails.
+func (r *projectResource) waitForStatus(ctx context.Context, project *traceforce.HostingEnvironment, target string, timeout time.Duration) (*traceforce.HostingEnvironment, error) {
+	if string(project.Status) == target {
+		return project, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	latest, err := waitForStatus(ctx, func() (*traceforce.HostingEnvironment, string, error) {
+		current, err := r.client.GetHostingEnvironment(ctx, project.ID)
+		if err != nil {
+			return nil, "", err
+		}
+		return current, string(current.Status), nil
+	}, target, []string{string(traceforce.HostingEnvironmentStatusDisconnected)})
+	if latest == nil {
+		latest = project
+	}
+
+	return latest, err
+}
+
+// ImportState imports a project by its ID, by its name in the form
+// name:<name>, or by its native ID in the form <cloud_provider>:<native_id>.
 func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import project by ID
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	prefix, value, ok := strings.Cut(req.ID, ":")
+	if !ok {
+		// Import project by ID, given either as the import ID or as
+		// the identity
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+
+	var description string
+	var matchesID func(env traceforce.HostingEnvironment) bool
+	switch prefix {
+	case "name":
+		description = fmt.Sprintf("name %q", value)
+		matchesID = func(env traceforce.HostingEnvironment) bool {
+			return env.Name == value
+		}
+	case string(traceforce.CloudProviderAWS), string(traceforce.CloudProviderGCP), string(traceforce.CloudProviderAzure):
+		description = fmt.Sprintf("cloud_provider %q and native_id %q", prefix, value)
+		matchesID = func(env traceforce.HostingEnvironment) bool {
+			return string(env.CloudProvider) == prefix && env.NativeID == value
+		}
+	default:
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a project ID or an import identifier with format name:<name> or <cloud_provider>:<native_id>, "+
+				"where cloud_provider is one of %s, %s, %s, got: %q",
+				traceforce.CloudProviderAWS, traceforce.CloudProviderGCP, traceforce.CloudProviderAzure, req.ID),
+		)
+		return
+	}
+
+	hostingEnvironments, err := r.client.GetHostingEnvironments(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error reading hosting environments", err))
+		return
+	}
+
+	var matches []traceforce.HostingEnvironment
+	for _, env := range hostingEnvironments {
+		if matchesID(env) {
+			matches = append(matches, env)
+		}
+	}
+
+	match, ok := lookupOne(matches, func(env traceforce.HostingEnvironment) string { return env.ID }, "project", description, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), match.ID)...)
 }
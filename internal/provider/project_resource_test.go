@@ -4,10 +4,16 @@
 package provider
 
 import (
+	"fmt"
+	"net/http"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
 )
 
 func TestAccProjectResource(t *testing.T) {
@@ -24,22 +30,20 @@ func TestAccProjectResource(t *testing.T) {
 				Config: providerConfig + `
 resource "traceforce_project" "test" {
   name           = "` + resourceName + `"
-  type           = "Customer Managed"
-  cloud_provider = "AWS"
-  native_id      = "9876543210"
+  type           = "customer_managed"
+  cloud_provider = "aws"
+  native_id      = "987654321098"
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify attributes
 					resource.TestCheckResourceAttr("traceforce_project.test", "name", resourceName),
-					resource.TestCheckResourceAttr("traceforce_project.test", "type", "Customer Managed"),
-					resource.TestCheckResourceAttr("traceforce_project.test", "cloud_provider", "AWS"),
-					resource.TestCheckResourceAttr("traceforce_project.test", "native_id", "9876543210"),
+					resource.TestCheckResourceAttr("traceforce_project.test", "type", "customer_managed"),
+					resource.TestCheckResourceAttr("traceforce_project.test", "cloud_provider", "aws"),
+					resource.TestCheckResourceAttr("traceforce_project.test", "native_id", "987654321098"),
 					// Verify dynamic values have any value set in the state.
 					resource.TestCheckResourceAttrSet("traceforce_project.test", "id"),
 					resource.TestCheckResourceAttrSet("traceforce_project.test", "status"),
-					resource.TestCheckResourceAttrSet("traceforce_project.test", "control_plane_aws_account_id"),
-					resource.TestCheckResourceAttrSet("traceforce_project.test", "control_plane_role_name"),
 					resource.TestCheckResourceAttrSet("traceforce_project.test", "created_at"),
 					resource.TestCheckResourceAttrSet("traceforce_project.test", "updated_at"),
 				),
@@ -48,7 +52,7 @@ resource "traceforce_project" "test" {
 			{
 				ResourceName:      "traceforce_project.test",
 				ImportState:       true,
-				ImportStateId:     resourceName,
+				ImportStateId:     "name:" + resourceName,
 				ImportStateVerify: true,
 				// The updated_at attribute may not match during import
 				ImportStateVerifyIgnore: []string{"updated_at"},
@@ -58,15 +62,15 @@ resource "traceforce_project" "test" {
 				Config: providerConfig + `
 resource "traceforce_project" "test" {
   name           = "` + resourceName + `"
-  type           = "TraceForce Managed"
-  cloud_provider = "GCP"
+  type           = "traceforce_managed"
+  cloud_provider = "gcp"
   native_id      = "my-gcp-project"
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify updated attributes
-					resource.TestCheckResourceAttr("traceforce_project.test", "type", "TraceForce Managed"),
-					resource.TestCheckResourceAttr("traceforce_project.test", "cloud_provider", "GCP"),
+					resource.TestCheckResourceAttr("traceforce_project.test", "type", "traceforce_managed"),
+					resource.TestCheckResourceAttr("traceforce_project.test", "cloud_provider", "gcp"),
 					resource.TestCheckResourceAttr("traceforce_project.test", "native_id", "my-gcp-project"),
 				),
 			},
@@ -74,3 +78,249 @@ resource "traceforce_project" "test" {
 		},
 	})
 }
+
+func TestProjectResourceCreateWaitForStatus(t *testing.T) {
+	setStatusPollInterval(t, time.Millisecond)
+
+	testCases := map[string]struct {
+		finalStatus    traceforce.HostingEnvironmentStatus
+		expectError    bool
+		expectedStatus string
+	}{
+		"connected": {
+			finalStatus:    traceforce.HostingEnvironmentStatusConnected,
+			expectedStatus: "connected",
+		},
+		"disconnected": {
+			finalStatus:    traceforce.HostingEnvironmentStatusDisconnected,
+			expectError:    true,
+			expectedStatus: "disconnected",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			f := newFakeAPI(t)
+
+			// The project is pending on creation and the first poll, then
+			// reaches its final status.
+			f.transition("test", "pending", "pending", string(testCase.finalStatus))
+
+			resp := f.createResource(t, NewProjectResource(), &projectResourceModel{
+				ID:            types.StringUnknown(),
+				Name:          types.StringValue("test"),
+				Type:          types.StringValue(string(traceforce.HostingEnvironmentTypeCustomerManaged)),
+				CloudProvider: types.StringValue(string(traceforce.CloudProviderGCP)),
+				NativeId:      types.StringValue("my-gcp-project"),
+				Status:        types.StringUnknown(),
+				CreatedAt:     types.StringUnknown(),
+				UpdatedAt:     types.StringUnknown(),
+				WaitForStatus: types.StringValue(string(traceforce.HostingEnvironmentStatusConnected)),
+				Timeouts:      nullTimeouts("create", "update"),
+			})
+
+			if testCase.expectError != resp.Diagnostics.HasError() {
+				t.Fatalf("expected error: %t, got diagnostics: %v", testCase.expectError, resp.Diagnostics)
+			}
+
+			var state projectResourceModel
+			if diags := resp.State.Get(t.Context(), &state); diags.HasError() {
+				t.Fatalf("unexpected state diagnostics: %v", diags)
+			}
+			if len(f.hostingEnvironments) != 1 || state.ID.ValueString() != f.hostingEnvironments[0].ID {
+				t.Errorf("expected created project to be kept in state, got %q", state.ID.ValueString())
+			}
+			if state.Status.ValueString() != testCase.expectedStatus {
+				t.Errorf("expected status %q, got %q", testCase.expectedStatus, state.Status.ValueString())
+			}
+		})
+	}
+}
+func TestProjectResourceImportState(t *testing.T) {
+	f := newFakeAPI(t)
+	f.add(
+		traceforce.HostingEnvironment{ID: "project-1", Name: "production", CloudProvider: traceforce.CloudProviderGCP, NativeID: "my-gcp-project"},
+		traceforce.HostingEnvironment{ID: "project-2", Name: "staging", CloudProvider: traceforce.CloudProviderAWS, NativeID: "123456789012"},
+		traceforce.HostingEnvironment{ID: "project-3", Name: "staging", CloudProvider: traceforce.CloudProviderAzure, NativeID: "my-subscription"},
+	)
+
+	testCases := map[string]struct {
+		importID    string
+		expectedID  string
+		expectError bool
+	}{
+		"id": {
+			importID:   "project-1",
+			expectedID: "project-1",
+		},
+		"name": {
+			importID:   "name:production",
+			expectedID: "project-1",
+		},
+		"native-id": {
+			importID:   "aws:123456789012",
+			expectedID: "project-2",
+		},
+		"native-id-wrong-cloud-provider": {
+			importID:    "gcp:123456789012",
+			expectError: true,
+		},
+		"name-not-found": {
+			importID:    "name:missing",
+			expectError: true,
+		},
+		"name-ambiguous": {
+			importID:    "name:staging",
+			expectError: true,
+		},
+		"unknown-prefix": {
+			importID:    "oci:my-tenancy",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := &projectResource{client: f.client()}
+
+			if id := testImportState(t, r, testCase.importID, testCase.expectError); id != testCase.expectedID {
+				t.Errorf("expected id %q, got %q", testCase.expectedID, id)
+			}
+		})
+	}
+}
+func TestProjectResourceLifecycle(t *testing.T) {
+	f := newFakeAPI(t)
+
+	testResourceLifecycle(t, f, NewProjectResource, lifecycleTestCase[projectResourceModel]{
+		create: projectResourceModel{
+			ID:            types.StringUnknown(),
+			Name:          types.StringValue("production"),
+			Type:          types.StringValue(string(traceforce.HostingEnvironmentTypeCustomerManaged)),
+			CloudProvider: types.StringValue(string(traceforce.CloudProviderAWS)),
+			NativeId:      types.StringValue("987654321098"),
+			Status:        types.StringUnknown(),
+			CreatedAt:     types.StringUnknown(),
+			UpdatedAt:     types.StringUnknown(),
+			WaitForStatus: types.StringNull(),
+			Timeouts:      nullTimeouts("create", "update"),
+		},
+		update: func(state projectResourceModel) projectResourceModel {
+			state.Name = types.StringValue("production-updated")
+			state.UpdatedAt = types.StringUnknown()
+			return state
+		},
+		check: func(t *testing.T, step string, state projectResourceModel) {
+			expectedName := map[string]string{"create": "production", "update": "production-updated"}[step]
+			if state.Name.ValueString() != expectedName {
+				t.Errorf("%s: expected name %q, got %q", step, expectedName, state.Name.ValueString())
+			}
+			if len(f.hostingEnvironments) != 1 || state.ID.ValueString() != f.hostingEnvironments[0].ID {
+				t.Errorf("%s: expected id of the created project, got %q", step, state.ID.ValueString())
+			}
+			if state.NativeId.ValueString() != "987654321098" || state.Status.ValueString() != "pending" {
+				t.Errorf("%s: expected native_id and status to be set, got %q and %q", step, state.NativeId.ValueString(), state.Status.ValueString())
+			}
+			if state.CreatedAt.IsUnknown() || state.UpdatedAt.IsUnknown() {
+				t.Errorf("%s: expected timestamps to be set, got %s and %s", step, state.CreatedAt, state.UpdatedAt)
+			}
+		},
+	})
+}
+
+func TestUnitProjectResource(t *testing.T) {
+	f := newFakeAPI(t)
+	var projectID string
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             f.checkDestroyed,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: f.providerConfig() + `
+resource "traceforce_project" "test" {
+  name           = "production"
+  type           = "customer_managed"
+  cloud_provider = "aws"
+  native_id      = "987654321098"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("traceforce_project.test", "name", "production"),
+					resource.TestCheckResourceAttr("traceforce_project.test", "type", "customer_managed"),
+					resource.TestCheckResourceAttr("traceforce_project.test", "cloud_provider", "aws"),
+					resource.TestCheckResourceAttr("traceforce_project.test", "native_id", "987654321098"),
+					resource.TestCheckResourceAttr("traceforce_project.test", "status", "pending"),
+					resource.TestCheckResourceAttrSet("traceforce_project.test", "created_at"),
+					resource.TestCheckResourceAttrSet("traceforce_project.test", "updated_at"),
+					resource.TestCheckResourceAttrWith("traceforce_project.test", "id", func(value string) error {
+						projectID = value
+						return nil
+					}),
+				),
+			},
+			// ImportState testing by ID, name and native ID
+			{
+				ResourceName:      "traceforce_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "traceforce_project.test",
+				ImportState:       true,
+				ImportStateId:     "name:production",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "traceforce_project.test",
+				ImportState:       true,
+				ImportStateId:     "aws:987654321098",
+				ImportStateVerify: true,
+			},
+			// Read errors fail the plan
+			{
+				PreConfig: func() {
+					f.fail(http.MethodGet, "/hosting-environments/", http.StatusInternalServerError, 1)
+				},
+				RefreshState: true,
+				ExpectError:  regexp.MustCompile(`Error reading hosting environment`),
+			},
+			// Projects deleted outside of Terraform are recreated
+			{
+				PreConfig: func() {
+					if err := f.client().DeleteHostingEnvironment(t.Context(), projectID); err != nil {
+						t.Fatalf("unexpected error deleting project: %v", err)
+					}
+				},
+				Config: f.providerConfig() + `
+resource "traceforce_project" "test" {
+  name           = "production"
+  type           = "customer_managed"
+  cloud_provider = "aws"
+  native_id      = "987654321098"
+}
+`,
+				Check: resource.TestCheckResourceAttrWith("traceforce_project.test", "id", func(value string) error {
+					if value == projectID {
+						return fmt.Errorf("expected project to be recreated with a new ID, got %s", value)
+					}
+					return nil
+				}),
+			},
+			// Update and Read testing
+			{
+				Config: f.providerConfig() + `
+resource "traceforce_project" "test" {
+  name           = "production-updated"
+  type           = "customer_managed"
+  cloud_provider = "aws"
+  native_id      = "987654321098"
+}
+`,
+				Check: resource.TestCheckResourceAttr("traceforce_project.test", "name", "production-updated"),
+			},
+		},
+	})
+}
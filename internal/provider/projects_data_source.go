@@ -25,11 +25,12 @@ func NewProjectsDataSource() datasource.DataSource {
 }
 
 type projectsDataSource struct {
-	client *traceforce.Client
+	client *apiClient
 }
 
 // projectsDataSourceModel maps the data source schema data.
 type projectsDataSourceModel struct {
+	Filter   *filterModel    `tfsdk:"filter"`
 	Projects []projectsModel `tfsdk:"projects"`
 }
 
@@ -57,11 +58,11 @@ func (d *projectsDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*traceforce.Client)
+	client, ok := req.ProviderData.(*apiClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *traceforce.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -122,35 +123,64 @@ func (d *projectsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": filterBlock("project",
+				stringValues(traceforce.HostingEnvironmentStatusPending, traceforce.HostingEnvironmentStatusDisconnected, traceforce.HostingEnvironmentStatusConnected),
+				stringValues(traceforce.HostingEnvironmentTypeCustomerManaged, traceforce.HostingEnvironmentTypeTraceForceManaged)),
+		},
 	}
 }
 
 func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config projectsDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API cannot filter hosting environments, so they are filtered here
+	filter, diags := parseFilter(config.Filter)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get all hosting environments (projects)
-	hostingEnvironments, err := d.client.GetHostingEnvironments()
+	hostingEnvironments, err := d.client.GetHostingEnvironments(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading hosting environments", err.Error())
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error reading hosting environments", err))
 		return
 	}
 
-	var state projectsDataSourceModel
+	state := projectsDataSourceModel{Filter: config.Filter, Projects: []projectsModel{}}
 	for _, env := range hostingEnvironments {
-		state.Projects = append(state.Projects, projectsModel{
-			ID:            types.StringValue(env.ID),
-			CreatedAt:     types.StringValue(env.CreatedAt.Format(time.RFC3339)),
-			UpdatedAt:     types.StringValue(env.UpdatedAt.Format(time.RFC3339)),
-			Name:          types.StringValue(env.Name),
-			Type:          types.StringValue(string(env.Type)),
-			CloudProvider: types.StringValue(string(env.CloudProvider)),
-			NativeId:      types.StringValue(env.NativeID),
-			Status:        types.StringValue(string(env.Status)),
-		})
+		if !filter.matches(env.Name, string(env.Status), string(env.Type), string(env.CloudProvider), env.CreatedAt) {
+			continue
+		}
+		state.Projects = append(state.Projects, projectsModelFromAPI(&env))
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
+
+// projectsModelFromAPI converts a hosting environment returned by the API to
+// its data source model.
+func projectsModelFromAPI(env *traceforce.HostingEnvironment) projectsModel {
+	return projectsModel{
+		ID:            types.StringValue(env.ID),
+		CreatedAt:     types.StringValue(env.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:     types.StringValue(env.UpdatedAt.Format(time.RFC3339)),
+		Name:          types.StringValue(env.Name),
+		Type:          types.StringValue(string(env.Type)),
+		CloudProvider: types.StringValue(string(env.CloudProvider)),
+		NativeId:      types.StringValue(env.NativeID),
+		Status:        types.StringValue(string(env.Status)),
+	}
+}
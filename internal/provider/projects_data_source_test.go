@@ -20,12 +20,35 @@ func TestAccProjectsDataSource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify the first project to ensure all attributes are set
 					resource.TestCheckResourceAttr("data.traceforce_projects.test", "projects.0.name", "test-project-1"),
-					resource.TestCheckResourceAttr("data.traceforce_projects.test", "projects.0.type", "Customer Managed"),
-					resource.TestCheckResourceAttr("data.traceforce_projects.test", "projects.0.cloud_provider", "GCP"),
+					resource.TestCheckResourceAttr("data.traceforce_projects.test", "projects.0.type", "customer_managed"),
+					resource.TestCheckResourceAttr("data.traceforce_projects.test", "projects.0.cloud_provider", "gcp"),
 					resource.TestCheckResourceAttr("data.traceforce_projects.test", "projects.0.native_id", "test-project-1"),
-					resource.TestCheckResourceAttr("data.traceforce_projects.test", "projects.0.status", "Connected"),
-					resource.TestCheckResourceAttrSet("data.traceforce_projects.test", "projects.0.control_plane_aws_account_id"),
-					resource.TestCheckResourceAttrSet("data.traceforce_projects.test", "projects.0.control_plane_role_name"),
+					resource.TestCheckResourceAttr("data.traceforce_projects.test", "projects.0.status", "connected"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitProjectsDataSource(t *testing.T) {
+	f := newFakeAPI(t)
+	production := f.seed(t, "production")
+	staging := f.seed(t, "staging")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: f.providerConfig() + `data "traceforce_projects" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.traceforce_projects.test", "projects.#", "2"),
+					resource.TestCheckResourceAttr("data.traceforce_projects.test", "projects.0.id", production.projectID),
+					resource.TestCheckResourceAttr("data.traceforce_projects.test", "projects.0.name", "production"),
+					resource.TestCheckResourceAttr("data.traceforce_projects.test", "projects.0.type", "customer_managed"),
+					resource.TestCheckResourceAttr("data.traceforce_projects.test", "projects.0.cloud_provider", "gcp"),
+					resource.TestCheckResourceAttr("data.traceforce_projects.test", "projects.0.status", "pending"),
+					resource.TestCheckResourceAttr("data.traceforce_projects.test", "projects.1.id", staging.projectID),
 				),
 			},
 		},
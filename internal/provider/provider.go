@@ -5,13 +5,18 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"maps"
 	"os"
-
-	traceforce "github.com/traceforce/traceforce-go-sdk"
+	"slices"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -24,6 +29,7 @@ import (
 var _ provider.Provider = &traceforceProvider{}
 var _ provider.ProviderWithFunctions = &traceforceProvider{}
 var _ provider.ProviderWithEphemeralResources = &traceforceProvider{}
+var _ provider.ProviderWithListResources = &traceforceProvider{}
 
 // traceforceProvider defines the provider implementation.
 type traceforceProvider struct {
@@ -35,9 +41,12 @@ type traceforceProvider struct {
 
 // traceforceProviderModel describes the provider data model.
 type traceforceProviderModel struct {
-	Endpoint     types.String `tfsdk:"endpoint"`
-	ApiKey       types.String `tfsdk:"api_key"`
-	ExtraHeaders types.Map    `tfsdk:"extra_headers"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	ApiKey          types.String `tfsdk:"api_key"`
+	ExtraHeaders    types.Map    `tfsdk:"extra_headers"`
+	MaxRetries      types.Int64  `tfsdk:"max_retries"`
+	RetryMinBackoff types.String `tfsdk:"retry_min_backoff"`
+	RetryMaxBackoff types.String `tfsdk:"retry_max_backoff"`
 }
 
 func (p *traceforceProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -52,6 +61,7 @@ func (p *traceforceProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				Description:         "API key to the service. May also be provided via TRACEFORCE_API_KEY environment variable.",
 				MarkdownDescription: "API key to the service",
 				Optional:            true,
+				Sensitive:           true,
 			},
 			"endpoint": schema.StringAttribute{
 				Description:         "URI for Traceforce API. May also be provided via TRACEFORCE_ENDPOINT environment variable.",
@@ -64,6 +74,24 @@ func (p *traceforceProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of times a failed API request is retried. Defaults to %d. "+
+					"May also be provided via TRACEFORCE_MAX_RETRIES environment variable.", defaultMaxRetries),
+				MarkdownDescription: fmt.Sprintf("Maximum number of times a failed API request is retried. Defaults to `%d`", defaultMaxRetries),
+				Optional:            true,
+			},
+			"retry_min_backoff": schema.StringAttribute{
+				Description: fmt.Sprintf("Minimum time to wait before retrying a failed API request, as a duration such as \"500ms\" or \"2s\". Defaults to %s. "+
+					"May also be provided via TRACEFORCE_RETRY_MIN_BACKOFF environment variable.", defaultRetryMinBackoff),
+				MarkdownDescription: fmt.Sprintf("Minimum time to wait before retrying a failed API request, as a duration such as `500ms` or `2s`. Defaults to `%s`", defaultRetryMinBackoff),
+				Optional:            true,
+			},
+			"retry_max_backoff": schema.StringAttribute{
+				Description: fmt.Sprintf("Maximum time to wait between retries of a failed API request, including waits requested by a Retry-After response header, as a duration such as \"30s\" or \"1m\". Defaults to %s. "+
+					"May also be provided via TRACEFORCE_RETRY_MAX_BACKOFF environment variable.", defaultRetryMaxBackoff),
+				MarkdownDescription: fmt.Sprintf("Maximum time to wait between retries of a failed API request, including waits requested by a Retry-After response header, as a duration such as `30s` or `1m`. Defaults to `%s`", defaultRetryMaxBackoff),
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -98,6 +126,33 @@ func (p *traceforceProvider) Configure(ctx context.Context, req provider.Configu
 		)
 	}
 
+	if config.MaxRetries.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Unknown Traceforce API max retries",
+			"The provider cannot create the Traceforce API client as there is an unknown configuration value for the maximum number of retries. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the TRACEFORCE_MAX_RETRIES environment variable.",
+		)
+	}
+
+	if config.RetryMinBackoff.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_min_backoff"),
+			"Unknown Traceforce API retry minimum backoff",
+			"The provider cannot create the Traceforce API client as there is an unknown configuration value for the minimum retry backoff. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the TRACEFORCE_RETRY_MIN_BACKOFF environment variable.",
+		)
+	}
+
+	if config.RetryMaxBackoff.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_backoff"),
+			"Unknown Traceforce API retry maximum backoff",
+			"The provider cannot create the Traceforce API client as there is an unknown configuration value for the maximum retry backoff. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the TRACEFORCE_RETRY_MAX_BACKOFF environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -151,25 +206,106 @@ func (p *traceforceProvider) Configure(ctx context.Context, req provider.Configu
 		}
 	}
 
-	options := &traceforce.ClientOptions{
-		ExtraHeaders: extraHeaders,
+	retry := resolveRetryPolicy(config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	client, err := traceforce.NewClient(apiKey, endpoint, options)
+	client, err := newAPIClient(apiKey, endpoint, apiClientOptions{
+		ExtraHeaders: extraHeaders,
+		Retry:        retry,
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Traceforce API Client",
-			"An unexpected error occurred when creating the Traceforce API client. "+
-				"If the error is not clear, please contact the provider developers.\n\n"+
+			"An unexpected error occurred when creating the Traceforce API client.\n\n"+
 				"Traceforce Client Error: "+err.Error(),
 		)
 		return
 	}
 
+	tflog.Debug(ctx, "Configured Traceforce API client", map[string]any{
+		"endpoint":           client.baseURL,
+		"max_retries":        retry.MaxRetries,
+		"retry_min_backoff":  retry.MinBackoff.String(),
+		"retry_max_backoff":  retry.MaxBackoff.String(),
+		"extra_header_names": slices.Sorted(maps.Keys(extraHeaders)),
+	})
+
 	// Make the HashiCups client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ListResourceData = client
+}
+
+// resolveRetryPolicy builds the API retry policy from the defaults, the
+// environment and the provider configuration, in increasing order of
+// precedence. Null configuration values leave the environment in effect.
+func resolveRetryPolicy(config traceforceProviderModel, diags *diag.Diagnostics) retryPolicy {
+	retry := defaultRetryPolicy()
+
+	if v := os.Getenv("TRACEFORCE_MAX_RETRIES"); v != "" {
+		maxRetries, err := strconv.Atoi(v)
+		if err != nil {
+			diags.AddError(
+				"Invalid TRACEFORCE_MAX_RETRIES environment variable",
+				fmt.Sprintf("The TRACEFORCE_MAX_RETRIES environment variable must be a whole number, got %q.", v),
+			)
+			return retry
+		}
+		retry.MaxRetries = maxRetries
+	}
+	if !config.MaxRetries.IsNull() {
+		retry.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	if retry.MaxRetries < 0 {
+		diags.AddAttributeError(
+			path.Root("max_retries"),
+			"Invalid Traceforce API max retries",
+			fmt.Sprintf("The maximum number of retries cannot be negative, got %d.", retry.MaxRetries),
+		)
+	}
+
+	retry.MinBackoff = parseBackoff(diags, path.Root("retry_min_backoff"), "TRACEFORCE_RETRY_MIN_BACKOFF", config.RetryMinBackoff, retry.MinBackoff)
+	retry.MaxBackoff = parseBackoff(diags, path.Root("retry_max_backoff"), "TRACEFORCE_RETRY_MAX_BACKOFF", config.RetryMaxBackoff, retry.MaxBackoff)
+
+	if retry.MinBackoff > retry.MaxBackoff {
+		diags.AddAttributeError(
+			path.Root("retry_min_backoff"),
+			"Invalid Traceforce API retry backoff",
+			fmt.Sprintf("The minimum retry backoff (%s) cannot be greater than the maximum retry backoff (%s).", retry.MinBackoff, retry.MaxBackoff),
+		)
+	}
+
+	return retry
+}
+
+// parseBackoff resolves a backoff duration from the configuration value or,
+// if it is not set, the environment variable, falling back to defaultValue.
+func parseBackoff(diags *diag.Diagnostics, attrPath path.Path, envVar string, value types.String, defaultValue time.Duration) time.Duration {
+	raw := os.Getenv(envVar)
+	if !value.IsNull() && value.ValueString() != "" {
+		raw = value.ValueString()
+	}
+
+	if raw == "" {
+		return defaultValue
+	}
+
+	backoff, err := time.ParseDuration(raw)
+	if err != nil || backoff < 0 {
+		diags.AddAttributeError(
+			attrPath,
+			"Invalid Traceforce API retry backoff",
+			fmt.Sprintf("The retry backoff must be a non-negative duration such as \"500ms\", \"2s\" or \"1m\", got %q. "+
+				"Set the value in the configuration or use the %s environment variable.", raw, envVar),
+		)
+		return defaultValue
+	}
+
+	return backoff
 }
 
 func (p *traceforceProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -188,14 +324,31 @@ func (p *traceforceProvider) EphemeralResources(ctx context.Context) []func() ep
 
 func (p *traceforceProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewProjectDataSource,
 		NewProjectsDataSource,
+		NewDatalakeDataSource,
 		NewDatalakesDataSource,
+		NewSourceAppDataSource,
 		NewSourceAppsDataSource,
+		NewSourceAppDatalakeLinksDataSource,
+	}
+}
+
+func (p *traceforceProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewProjectListResource,
+		NewDatalakeListResource,
+		NewSourceAppListResource,
+		NewSourceAppDatalakeLinkListResource,
 	}
 }
 
 func (p *traceforceProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewParseLinkIDFunction,
+		NewGCPWorkloadIdentityProviderNameFunction,
+		NewModuleVersionsFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
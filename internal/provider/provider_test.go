@@ -4,11 +4,22 @@
 package provider
 
 import (
+	"fmt"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 const (
@@ -42,3 +53,141 @@ func testAccPreCheck(t *testing.T) {
 			"Set it to run tests against the live API: export TRACEFORCE_API_KEY=\"your-api-key\"")
 	}
 }
+
+// testAccImportStateIDFunc returns the import ID of the given resource built by
+// joining the given state attributes with slashes.
+func testAccImportStateIDFunc(resourceName string, attributes ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		values := make([]string, 0, len(attributes))
+		for _, attribute := range attributes {
+			values = append(values, rs.Primary.Attributes[attribute])
+		}
+		return strings.Join(values, "/"), nil
+	}
+}
+
+// testImportState imports a resource by the given import ID and returns the
+// ID it resolved to. It fails the test if the import fails unexpectedly.
+func testImportState(t *testing.T, r fwresource.ResourceWithImportState, importID string, expectError bool) string {
+	t.Helper()
+	ctx := t.Context()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	resp := &fwresource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: importID}, resp)
+
+	if expectError != resp.Diagnostics.HasError() {
+		t.Fatalf("expected error: %t, got diagnostics: %v", expectError, resp.Diagnostics)
+	}
+	if expectError {
+		return ""
+	}
+
+	var id types.String
+	if diags := resp.State.GetAttribute(ctx, path.Root("id"), &id); diags.HasError() {
+		t.Fatalf("unexpected state diagnostics: %v", diags)
+	}
+	return id.ValueString()
+}
+
+func TestProviderRetryPolicy(t *testing.T) {
+	testCases := map[string]struct {
+		env           map[string]string
+		config        traceforceProviderModel
+		expected      retryPolicy
+		expectedError string
+	}{
+		"defaults": {
+			expected: defaultRetryPolicy(),
+		},
+		"environment": {
+			env: map[string]string{
+				"TRACEFORCE_MAX_RETRIES":       "5",
+				"TRACEFORCE_RETRY_MIN_BACKOFF": "2s",
+				"TRACEFORCE_RETRY_MAX_BACKOFF": "1m",
+			},
+			expected: retryPolicy{MaxRetries: 5, MinBackoff: 2 * time.Second, MaxBackoff: time.Minute},
+		},
+		"configuration-overrides-environment": {
+			env: map[string]string{
+				"TRACEFORCE_MAX_RETRIES":       "5",
+				"TRACEFORCE_RETRY_MIN_BACKOFF": "2s",
+			},
+			config: traceforceProviderModel{
+				MaxRetries:      types.Int64Value(1),
+				RetryMinBackoff: types.StringValue("100ms"),
+			},
+			expected: retryPolicy{MaxRetries: 1, MinBackoff: 100 * time.Millisecond, MaxBackoff: defaultRetryMaxBackoff},
+		},
+		"invalid-max-retries": {
+			env: map[string]string{
+				"TRACEFORCE_MAX_RETRIES": "three",
+			},
+			expected:      defaultRetryPolicy(),
+			expectedError: "Invalid TRACEFORCE_MAX_RETRIES environment variable",
+		},
+		"negative-max-retries": {
+			config: traceforceProviderModel{
+				MaxRetries: types.Int64Value(-1),
+			},
+			expectedError: "Invalid Traceforce API max retries",
+		},
+		"invalid-backoff": {
+			env: map[string]string{
+				"TRACEFORCE_RETRY_MAX_BACKOFF": "soon",
+			},
+			expectedError: "Invalid Traceforce API retry backoff",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for _, envVar := range []string{"TRACEFORCE_MAX_RETRIES", "TRACEFORCE_RETRY_MIN_BACKOFF", "TRACEFORCE_RETRY_MAX_BACKOFF"} {
+				t.Setenv(envVar, testCase.env[envVar])
+			}
+
+			config := testCase.config
+			if config.MaxRetries == (types.Int64{}) {
+				config.MaxRetries = types.Int64Null()
+			}
+			if config.RetryMinBackoff == (types.String{}) {
+				config.RetryMinBackoff = types.StringNull()
+			}
+			if config.RetryMaxBackoff == (types.String{}) {
+				config.RetryMaxBackoff = types.StringNull()
+			}
+
+			var diags diag.Diagnostics
+			retry := resolveRetryPolicy(config, &diags)
+
+			if testCase.expectedError != "" {
+				errs := diags.Errors()
+				if len(errs) != 1 || errs[0].Summary() != testCase.expectedError {
+					t.Fatalf("expected a single %q error, got: %v", testCase.expectedError, diags)
+				}
+				if testCase.expected != (retryPolicy{}) && retry != testCase.expected {
+					t.Errorf("expected retry policy %+v, got %+v", testCase.expected, retry)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if retry != testCase.expected {
+				t.Errorf("expected retry policy %+v, got %+v", testCase.expected, retry)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMaxRetries      = 3
+	defaultRetryMinBackoff = 1 * time.Second
+	defaultRetryMaxBackoff = 30 * time.Second
+)
+
+// retryPolicy controls how failed API requests are retried.
+type retryPolicy struct {
+	// MaxRetries is the number of times a request is retried after the
+	// initial attempt. Zero disables retries.
+	MaxRetries int
+
+	// MinBackoff is the wait before the first retry. Later retries back off
+	// exponentially from it.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the exponential backoff and the wait requested by a
+	// Retry-After header.
+	MaxBackoff time.Duration
+}
+
+// defaultRetryPolicy returns the retry policy used when the provider
+// configuration does not override it.
+func defaultRetryPolicy() retryPolicy {
+	return retryPolicy{
+		MaxRetries: defaultMaxRetries,
+		MinBackoff: defaultRetryMinBackoff,
+		MaxBackoff: defaultRetryMaxBackoff,
+	}
+}
+
+// backoff returns how long to wait before retrying after the given attempt
+// (starting at zero). A Retry-After header on the response takes precedence
+// over the computed backoff when it asks for a longer wait, up to MaxBackoff.
+func (p retryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	wait := p.MinBackoff
+	for i := 0; i < attempt && wait < p.MaxBackoff; i++ {
+		wait *= 2
+	}
+	wait = min(wait, p.MaxBackoff)
+
+	// Apply equal jitter so concurrent clients do not retry in lockstep.
+	if half := wait / 2; half > 0 {
+		wait = half + rand.N(half+1)
+	}
+
+	if retryAfter, ok := parseRetryAfter(resp); ok && retryAfter > wait {
+		wait = min(retryAfter, p.MaxBackoff)
+	}
+
+	return wait
+}
+
+// parseRetryAfter returns the wait requested by the Retry-After header of
+// resp, which holds either a number of seconds or an HTTP date.
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+// shouldRetry reports whether a request that ended with resp or err can be
+// safely sent again. Idempotent requests are retried on transient server and
+// network errors. Other requests are only retried when the API rejected them
+// before processing (429) or when the connection could not be established.
+func shouldRetry(method string, resp *http.Response, err error) bool {
+	if err != nil {
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return true
+		}
+
+		return isIdempotent(method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	default:
+		return false
+	}
+}
+
+// isIdempotent reports whether repeating a request with the given method has
+// the same effect as sending it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+func TestAPIClientRetry(t *testing.T) {
+	testCases := map[string]struct {
+		statusCodes      []int
+		retryAfter       string
+		call             func(ctx context.Context, c *apiClient) error
+		expectedAttempts int32
+		expectError      bool
+	}{
+		"get-retried-on-bad-gateway": {
+			statusCodes:      []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK},
+			call:             func(ctx context.Context, c *apiClient) error { _, err := c.GetHostingEnvironments(ctx); return err },
+			expectedAttempts: 3,
+		},
+		"get-gives-up-after-max-retries": {
+			statusCodes:      []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusOK},
+			call:             func(ctx context.Context, c *apiClient) error { _, err := c.GetHostingEnvironments(ctx); return err },
+			expectedAttempts: 4,
+			expectError:      true,
+		},
+		"post-retried-on-too-many-requests": {
+			statusCodes: []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter:  "0",
+			call: func(ctx context.Context, c *apiClient) error {
+				_, err := c.CreateSourceApp(ctx, traceforce.CreateSourceAppRequest{})
+				return err
+			},
+			expectedAttempts: 2,
+		},
+		"post-not-retried-on-bad-gateway": {
+			statusCodes: []int{http.StatusBadGateway, http.StatusOK},
+			call: func(ctx context.Context, c *apiClient) error {
+				_, err := c.CreateSourceApp(ctx, traceforce.CreateSourceAppRequest{})
+				return err
+			},
+			expectedAttempts: 1,
+			expectError:      true,
+		},
+		"client-errors-not-retried": {
+			statusCodes:      []int{http.StatusBadRequest, http.StatusOK},
+			call:             func(ctx context.Context, c *apiClient) error { _, err := c.GetDatalakes(ctx); return err },
+			expectedAttempts: 1,
+			expectError:      true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				statusCode := testCase.statusCodes[attempts.Add(1)-1]
+				if statusCode == http.StatusTooManyRequests && testCase.retryAfter != "" {
+					w.Header().Set("Retry-After", testCase.retryAfter)
+				}
+				w.WriteHeader(statusCode)
+				if statusCode == http.StatusOK {
+					_, _ = w.Write([]byte(`null`))
+				}
+			}))
+			defer server.Close()
+
+			client := mustAPIClient("test-key", server.URL, apiClientOptions{
+				Retry: retryPolicy{
+					MaxRetries: 3,
+					MinBackoff: time.Millisecond,
+					MaxBackoff: 5 * time.Millisecond,
+				},
+			})
+
+			err := testCase.call(t.Context(), client)
+			if testCase.expectError && err == nil {
+				t.Error("expected error, got none")
+			}
+			if !testCase.expectError && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			if got := attempts.Load(); got != testCase.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", testCase.expectedAttempts, got)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := retryPolicy{
+		MaxRetries: 5,
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: time.Second,
+	}
+
+	for attempt, ceiling := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		wait := policy.backoff(attempt, nil)
+		if wait < ceiling/2 || wait > ceiling {
+			t.Errorf("attempt %d: expected backoff between %s and %s, got %s", attempt, ceiling/2, ceiling, wait)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	if wait := policy.backoff(0, resp); wait != policy.MaxBackoff {
+		t.Errorf("expected Retry-After of 7s to be capped to %s, got %s", policy.MaxBackoff, wait)
+	}
+
+	longer := policy
+	longer.MaxBackoff = 10 * time.Second
+	if wait := longer.backoff(0, resp); wait != 7*time.Second {
+		t.Errorf("expected Retry-After of 7s to be honoured, got %s", wait)
+	}
+
+	resp.Header.Set("Retry-After", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	if wait := policy.backoff(0, resp); wait > 100*time.Millisecond {
+		t.Errorf("expected past Retry-After date to fall back to backoff, got %s", wait)
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &sourceAppDataSource{}
+	_ datasource.DataSourceWithConfigure        = &sourceAppDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &sourceAppDataSource{}
+)
+
+func NewSourceAppDataSource() datasource.DataSource {
+	return &sourceAppDataSource{}
+}
+
+// sourceAppDataSource looks up a single source app by ID, or by name within a
+// hosting environment.
+type sourceAppDataSource struct {
+	client *apiClient
+}
+
+func (d *sourceAppDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_app"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *sourceAppDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *sourceAppDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single source app by id, or by name within a hosting environment.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "System generated ID of the source app. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the source app. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"hosting_environment_id": schema.StringAttribute{
+				Description: "ID of the hosting environment this source app belongs to. Required when looking up by name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: fmt.Sprintf("Type of source app. Valid values: %s.", traceforce.SourceAppTypeSalesforce),
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: fmt.Sprintf("Status of the source app. Valid values: %s, %s, %s, %s.",
+					traceforce.SourceAppStatusPending,
+					traceforce.SourceAppStatusDeployed,
+					traceforce.SourceAppStatusDisconnected,
+					traceforce.SourceAppStatusConnected),
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Date and time the source app was created",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Date and time the source app was last updated",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *sourceAppDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("name"),
+			path.MatchRoot("hosting_environment_id"),
+		),
+	}
+}
+
+func (d *sourceAppDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config sourceAppsModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var sourceApp traceforce.SourceApp
+	if !config.ID.IsNull() {
+		found, err := d.client.GetSourceApp(ctx, config.ID.ValueString())
+		if isNotFound(err) {
+			resp.Diagnostics.AddError("No source app found", fmt.Sprintf("No source app has id %q.", config.ID.ValueString()))
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic("Error reading source app", err))
+			return
+		}
+		sourceApp = *found
+	} else {
+		sourceApps, err := d.client.GetSourceAppsByHostingEnvironment(ctx, config.HostingEnvironmentId.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic("Error reading source apps by hosting environment", err))
+			return
+		}
+
+		var matches []traceforce.SourceApp
+		for _, candidate := range sourceApps {
+			if candidate.Name == config.Name.ValueString() {
+				matches = append(matches, candidate)
+			}
+		}
+
+		description := fmt.Sprintf("name %q in hosting environment %q", config.Name.ValueString(), config.HostingEnvironmentId.ValueString())
+		match, ok := lookupOne(matches, func(sourceApp traceforce.SourceApp) string { return sourceApp.ID }, "source app", description, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		sourceApp = match
+	}
+
+	// Set state
+	state := sourceAppsModel{
+		ID:                   types.StringValue(sourceApp.ID),
+		HostingEnvironmentId: types.StringValue(sourceApp.HostingEnvironmentID),
+		Type:                 types.StringValue(string(sourceApp.Type)),
+		Name:                 types.StringValue(sourceApp.Name),
+		Status:               types.StringValue(string(sourceApp.Status)),
+		CreatedAt:            types.StringValue(sourceApp.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:            types.StringValue(sourceApp.UpdatedAt.Format(time.RFC3339)),
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
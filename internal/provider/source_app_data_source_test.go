@@ -0,0 +1,117 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+func TestAccSourceAppDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "traceforce_source_apps" "all" {}
+
+data "traceforce_source_app" "by_id" {
+  id = data.traceforce_source_apps.all.source_apps[0].id
+}
+
+data "traceforce_source_app" "by_name" {
+  hosting_environment_id = data.traceforce_source_apps.all.source_apps[0].hosting_environment_id
+  name                   = data.traceforce_source_apps.all.source_apps[0].name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.traceforce_source_app.by_id", "name", "data.traceforce_source_apps.all", "source_apps.0.name"),
+					resource.TestCheckResourceAttrSet("data.traceforce_source_app.by_id", "type"),
+					resource.TestCheckResourceAttrSet("data.traceforce_source_app.by_id", "status"),
+					resource.TestCheckResourceAttrPair("data.traceforce_source_app.by_name", "id", "data.traceforce_source_app.by_id", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestSourceAppDataSourceRead(t *testing.T) {
+	f := newFakeAPI(t)
+	hostingEnvironmentID := uuid.New().String()
+	sourceApps := []traceforce.SourceApp{
+		{ID: uuid.New().String(), HostingEnvironmentID: hostingEnvironmentID, Name: "crm", Type: traceforce.SourceAppTypeSalesforce, Status: traceforce.SourceAppStatusConnected},
+		{ID: uuid.New().String(), HostingEnvironmentID: hostingEnvironmentID, Name: "shared", Type: traceforce.SourceAppTypeSalesforce},
+		{ID: uuid.New().String(), HostingEnvironmentID: hostingEnvironmentID, Name: "shared", Type: traceforce.SourceAppTypeSalesforce},
+	}
+	for _, sourceApp := range sourceApps {
+		f.add(sourceApp)
+	}
+
+	testLookup(t, f, NewSourceAppDataSource, map[string]lookupTestCase[sourceAppsModel]{
+		"by-id": {
+			config:     sourceAppsModel{ID: types.StringValue(sourceApps[0].ID)},
+			expectedID: sourceApps[0].ID,
+		},
+		"by-name": {
+			config:     sourceAppsModel{HostingEnvironmentId: types.StringValue(hostingEnvironmentID), Name: types.StringValue("crm")},
+			expectedID: sourceApps[0].ID,
+		},
+		"unknown-id": {
+			config:      sourceAppsModel{ID: types.StringValue(missingID)},
+			expectError: "No source app found",
+		},
+		"no-match": {
+			config:      sourceAppsModel{HostingEnvironmentId: types.StringValue(hostingEnvironmentID), Name: types.StringValue("missing")},
+			expectError: "No source app found",
+		},
+		"multiple-matches": {
+			config:      sourceAppsModel{HostingEnvironmentId: types.StringValue(hostingEnvironmentID), Name: types.StringValue("shared")},
+			expectError: "Multiple source apps found",
+		},
+	}, func(t *testing.T, state sourceAppsModel, expectedID string) {
+		if state.ID.ValueString() != expectedID {
+			t.Errorf("expected source app %q, got %q", expectedID, state.ID.ValueString())
+		}
+		if state.Status.ValueString() != string(traceforce.SourceAppStatusConnected) {
+			t.Errorf("expected status %q, got %q", traceforce.SourceAppStatusConnected, state.Status.ValueString())
+		}
+	})
+}
+func TestUnitSourceAppDataSource(t *testing.T) {
+	f := newFakeAPI(t)
+	account := f.seed(t, "production")
+	f.seed(t, "staging")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: f.providerConfig() + `
+data "traceforce_source_app" "by_id" {
+  id = "` + account.sourceAppID + `"
+}
+
+data "traceforce_source_app" "by_name" {
+  hosting_environment_id = "` + account.projectID + `"
+  name                   = "production-salesforce"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.traceforce_source_app.by_id", "hosting_environment_id", account.projectID),
+					resource.TestCheckResourceAttr("data.traceforce_source_app.by_id", "name", "production-salesforce"),
+					resource.TestCheckResourceAttr("data.traceforce_source_app.by_id", "type", "salesforce"),
+					resource.TestCheckResourceAttr("data.traceforce_source_app.by_id", "status", "pending"),
+					resource.TestCheckResourceAttr("data.traceforce_source_app.by_name", "id", account.sourceAppID),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,109 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &sourceAppDatalakeLinkListResource{}
+	_ list.ListResourceWithConfigure = &sourceAppDatalakeLinkListResource{}
+)
+
+// NewSourceAppDatalakeLinkListResource creates a new source app datalake link
+// list resource.
+func NewSourceAppDatalakeLinkListResource() list.ListResource {
+	return &sourceAppDatalakeLinkListResource{}
+}
+
+// sourceAppDatalakeLinkListResource lists existing source app datalake links
+// so that terraform query can generate configuration and import blocks for
+// them. It shares its metadata and configuration with the link resource.
+type sourceAppDatalakeLinkListResource struct {
+	sourceAppDatalakeLinkResource
+}
+
+// sourceAppDatalakeLinkListModel maps the source app datalake link list
+// configuration.
+type sourceAppDatalakeLinkListModel struct {
+	SourceAppID types.String `tfsdk:"source_app_id"`
+	DatalakeID  types.String `tfsdk:"datalake_id"`
+}
+
+// ListResourceConfigSchema defines the schema for list blocks.
+func (r *sourceAppDatalakeLinkListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the source app datalake links of the account.",
+		Attributes: map[string]schema.Attribute{
+			"source_app_id": schema.StringAttribute{
+				Description: "Only list links of this source app.",
+				Optional:    true,
+			},
+			"datalake_id": schema.StringAttribute{
+				Description: "Only list links to this datalake.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// List streams the source app datalake links matching the list configuration.
+func (r *sourceAppDatalakeLinkListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config sourceAppDatalakeLinkListModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var links []traceforce.SourceAppDatalakeLink
+	var err error
+
+	// The API filters by one of source app or datalake, the datalake is
+	// filtered below when both are set
+	switch {
+	case !config.SourceAppID.IsNull():
+		links, err = r.client.GetSourceAppDatalakeLinksBySourceApp(ctx, config.SourceAppID.ValueString())
+	case !config.DatalakeID.IsNull():
+		links, err = r.client.GetSourceAppDatalakeLinksByDatalake(ctx, config.DatalakeID.ValueString())
+	default:
+		links, err = r.client.GetSourceAppDatalakeLinks(ctx)
+	}
+	if err != nil {
+		stream.Results = listError("Error reading source app datalake links", err)
+		return
+	}
+
+	var matches []traceforce.SourceAppDatalakeLink
+	for _, link := range links {
+		if !config.DatalakeID.IsNull() && link.DatalakeID != config.DatalakeID.ValueString() {
+			continue
+		}
+		matches = append(matches, link)
+	}
+
+	stream.Results = listResults(ctx, req, matches, func(link traceforce.SourceAppDatalakeLink) (string, any, any) {
+		identity := sourceAppDatalakeLinkIdentityModel{
+			SourceAppID: types.StringValue(link.SourceAppID),
+			DatalakeID:  types.StringValue(link.DatalakeID),
+		}
+		return link.SourceAppID + "/" + link.DatalakeID, identity, sourceAppDatalakeLinkResourceModel{
+			ID:                   types.StringValue(link.ID),
+			SourceAppID:          types.StringValue(link.SourceAppID),
+			DatalakeID:           types.StringValue(link.DatalakeID),
+			HostingEnvironmentID: types.StringValue(link.HostingEnvironmentID),
+			CreatedAt:            types.StringValue(link.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt:            types.StringValue(link.UpdatedAt.Format(time.RFC3339)),
+		}
+	})
+}
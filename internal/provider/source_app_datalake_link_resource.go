@@ -5,10 +5,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -21,6 +24,7 @@ var (
 	_ resource.Resource                = &sourceAppDatalakeLinkResource{}
 	_ resource.ResourceWithConfigure   = &sourceAppDatalakeLinkResource{}
 	_ resource.ResourceWithImportState = &sourceAppDatalakeLinkResource{}
+	_ resource.ResourceWithIdentity    = &sourceAppDatalakeLinkResource{}
 )
 
 // NewSourceAppDatalakeLinkResource creates a new source app datalake link resource.
@@ -30,7 +34,7 @@ func NewSourceAppDatalakeLinkResource() resource.Resource {
 
 // sourceAppDatalakeLinkResource is the resource implementation.
 type sourceAppDatalakeLinkResource struct {
-	client *traceforce.Client
+	client *apiClient
 }
 
 // sourceAppDatalakeLinkResourceModel maps source app datalake link schema data.
@@ -43,6 +47,13 @@ type sourceAppDatalakeLinkResourceModel struct {
 	UpdatedAt            types.String `tfsdk:"updated_at"`
 }
 
+// sourceAppDatalakeLinkIdentityModel maps source app datalake link identity
+// data. A link is identified by the source app and datalake it links.
+type sourceAppDatalakeLinkIdentityModel struct {
+	SourceAppID types.String `tfsdk:"source_app_id"`
+	DatalakeID  types.String `tfsdk:"datalake_id"`
+}
+
 // Metadata returns the resource type name.
 func (r *sourceAppDatalakeLinkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_source_app_datalake_link"
@@ -55,7 +66,7 @@ func (r *sourceAppDatalakeLinkResource) Configure(ctx context.Context, req resou
 		return
 	}
 
-	client, ok := req.ProviderData.(*traceforce.Client)
+	client, ok := req.ProviderData.(*apiClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
@@ -121,6 +132,22 @@ func (r *sourceAppDatalakeLinkResource) Schema(_ context.Context, _ resource.Sch
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *sourceAppDatalakeLinkResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"source_app_id": identityschema.StringAttribute{
+				Description:       "ID of the linked source app.",
+				RequiredForImport: true,
+			},
+			"datalake_id": identityschema.StringAttribute{
+				Description:       "ID of the linked datalake.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *sourceAppDatalakeLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan sourceAppDatalakeLinkResourceModel
 
@@ -135,9 +162,9 @@ func (r *sourceAppDatalakeLinkResource) Create(ctx context.Context, req resource
 		DatalakeID:  plan.DatalakeID.ValueString(),
 	}
 
-	link, err := r.client.CreateSourceAppDatalakeLink(input)
+	link, err := r.client.CreateSourceAppDatalakeLink(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating source app datalake link", err.Error())
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error creating source app datalake link", err))
 		return
 	}
 
@@ -152,6 +179,7 @@ func (r *sourceAppDatalakeLinkResource) Create(ctx context.Context, req resource
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, sourceAppDatalakeLinkIdentityModel{SourceAppID: plan.SourceAppID, DatalakeID: plan.DatalakeID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -166,9 +194,12 @@ func (r *sourceAppDatalakeLinkResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
-	link, err := r.client.GetSourceAppDatalakeLink(state.ID.ValueString())
+	link, err := r.client.GetSourceAppDatalakeLink(ctx, state.ID.ValueString())
+	if removeIfNotFound(ctx, err, resp, "source app datalake link", state.ID.ValueString()) {
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading source app datalake link", err.Error())
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error reading source app datalake link", err))
 		return
 	}
 
@@ -183,6 +214,7 @@ func (r *sourceAppDatalakeLinkResource) Read(ctx context.Context, req resource.R
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, sourceAppDatalakeLinkIdentityModel{SourceAppID: state.SourceAppID, DatalakeID: state.DatalakeID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -206,14 +238,73 @@ func (r *sourceAppDatalakeLinkResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	err := r.client.DeleteSourceAppDatalakeLink(state.ID.ValueString())
+	err := r.client.DeleteSourceAppDatalakeLink(ctx, state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting source app datalake link", err.Error())
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error deleting source app datalake link", err))
 		return
 	}
 }
 
+// ImportState imports a link by its ID, or by the IDs of the source app and
+// datalake it links given either as its identity or in the form
+// <source_app_id>/<datalake_id>.
 func (r *sourceAppDatalakeLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import source app datalake link by id
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" && req.Identity != nil {
+		var identity sourceAppDatalakeLinkIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		r.importLink(ctx, identity.SourceAppID.ValueString(), identity.DatalakeID.ValueString(), resp)
+		return
+	}
+
+	if !strings.Contains(req.ID, "/") {
+		// Import source app datalake link by id
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	sourceAppID, datalakeID, ok := parseLinkID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a link ID or an import identifier with format <source_app_id>/<datalake_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	r.importLink(ctx, sourceAppID, datalakeID, resp)
+}
+
+// parseLinkID splits a link identifier of the form
+// <source_app_id>/<datalake_id> into the IDs of the linked objects.
+func parseLinkID(id string) (sourceAppID, datalakeID string, ok bool) {
+	sourceAppID, datalakeID, ok = strings.Cut(id, "/")
+	if !ok || sourceAppID == "" || datalakeID == "" || strings.Contains(datalakeID, "/") {
+		return "", "", false
+	}
+	return sourceAppID, datalakeID, true
+}
+
+// importLink imports the link between the given source app and datalake.
+func (r *sourceAppDatalakeLinkResource) importLink(ctx context.Context, sourceAppID, datalakeID string, resp *resource.ImportStateResponse) {
+	links, err := r.client.GetSourceAppDatalakeLinksBySourceApp(ctx, sourceAppID)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error reading source app datalake links", err))
+		return
+	}
+
+	for _, link := range links {
+		if link.DatalakeID == datalakeID {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), link.ID)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"No link found",
+		fmt.Sprintf("Source app %q is not linked to datalake %q. Check both IDs, or create the link with a "+
+			"traceforce_source_app_datalake_link resource instead of importing it.", sourceAppID, datalakeID),
+	)
 }
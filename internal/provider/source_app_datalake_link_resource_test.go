@@ -7,7 +7,13 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
 )
 
 func TestAccSourceAppDatalakeLinkResource(t *testing.T) {
@@ -24,33 +30,7 @@ func TestAccSourceAppDatalakeLinkResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: providerConfig + `
-resource "traceforce_project" "test" {
-  name           = "` + projectName + `"
-  type           = "Customer Managed"
-  cloud_provider = "GCP"
-  native_id      = "my-gcp-project"
-}
-
-resource "traceforce_datalake" "test" {
-  project_id            = traceforce_project.test.id
-  type                  = "BigQuery"
-  name                  = "` + datalakeName + `"
-  environment_native_id = "test-project-id"
-  region                = "us-central1"
-}
-
-resource "traceforce_source_app" "test" {
-  project_id = traceforce_project.test.id
-  type       = "Salesforce"
-  name       = "` + sourceAppName + `"
-}
-
-resource "traceforce_source_app_datalake_link" "test" {
-  source_app_id = traceforce_source_app.test.id
-  datalake_id   = traceforce_datalake.test.id
-}
-`,
+				Config: testAccSourceAppDatalakeLinkResourceConfig(projectName, datalakeName, sourceAppName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify attributes
 					resource.TestCheckResourceAttrSet("traceforce_source_app_datalake_link.test", "source_app_id"),
@@ -70,6 +50,14 @@ resource "traceforce_source_app_datalake_link" "test" {
 				// The updated_at attribute may not match during import
 				ImportStateVerifyIgnore: []string{"updated_at"},
 			},
+			// ImportState testing by source app and datalake ID
+			{
+				ResourceName:            "traceforce_source_app_datalake_link.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccImportStateIDFunc("traceforce_source_app_datalake_link.test", "source_app_id", "datalake_id"),
+				ImportStateVerifyIgnore: []string{"updated_at"},
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -92,44 +80,44 @@ func TestAccSourceAppDatalakeLinkResource_RequiresReplace(t *testing.T) {
 				Config: providerConfig + `
 resource "traceforce_project" "test1" {
   name           = "` + projectName1 + `"
-  type           = "Customer Managed"
-  cloud_provider = "GCP"
+  type           = "customer_managed"
+  cloud_provider = "gcp"
   native_id      = "my-gcp-project-1"
 }
 
 resource "traceforce_datalake" "test1" {
   project_id            = traceforce_project.test1.id
-  type                  = "BigQuery"
+  type                  = "bigquery"
   name                  = "` + datalakeName1 + `"
   environment_native_id = "test-project-id-1"
   region                = "us-central1"
 }
 
 resource "traceforce_source_app" "test1" {
-  project_id = traceforce_project.test1.id
-  type       = "Salesforce"
-  name       = "` + sourceAppName1 + `"
+  hosting_environment_id = traceforce_project.test1.id
+  type                   = "salesforce"
+  name                   = "` + sourceAppName1 + `"
 }
 
 resource "traceforce_project" "test2" {
   name           = "` + projectName2 + `"
-  type           = "Customer Managed"
-  cloud_provider = "GCP"
+  type           = "customer_managed"
+  cloud_provider = "gcp"
   native_id      = "my-gcp-project-2"
 }
 
 resource "traceforce_datalake" "test2" {
   project_id            = traceforce_project.test2.id
-  type                  = "BigQuery"
+  type                  = "bigquery"
   name                  = "` + datalakeName2 + `"
   environment_native_id = "test-project-id-2"
   region                = "us-central1"
 }
 
 resource "traceforce_source_app" "test2" {
-  project_id = traceforce_project.test2.id
-  type       = "Salesforce"
-  name       = "` + sourceAppName2 + `"
+  hosting_environment_id = traceforce_project.test2.id
+  type                   = "salesforce"
+  name                   = "` + sourceAppName2 + `"
 }
 
 resource "traceforce_source_app_datalake_link" "test" {
@@ -146,44 +134,44 @@ resource "traceforce_source_app_datalake_link" "test" {
 				Config: providerConfig + `
 resource "traceforce_project" "test1" {
   name           = "` + projectName1 + `"
-  type           = "Customer Managed"
-  cloud_provider = "GCP"
+  type           = "customer_managed"
+  cloud_provider = "gcp"
   native_id      = "my-gcp-project-1"
 }
 
 resource "traceforce_datalake" "test1" {
   project_id            = traceforce_project.test1.id
-  type                  = "BigQuery"
+  type                  = "bigquery"
   name                  = "` + datalakeName1 + `"
   environment_native_id = "test-project-id-1"
   region                = "us-central1"
 }
 
 resource "traceforce_source_app" "test1" {
-  project_id = traceforce_project.test1.id
-  type       = "Salesforce"
-  name       = "` + sourceAppName1 + `"
+  hosting_environment_id = traceforce_project.test1.id
+  type                   = "salesforce"
+  name                   = "` + sourceAppName1 + `"
 }
 
 resource "traceforce_project" "test2" {
   name           = "` + projectName2 + `"
-  type           = "Customer Managed"
-  cloud_provider = "GCP"
+  type           = "customer_managed"
+  cloud_provider = "gcp"
   native_id      = "my-gcp-project-2"
 }
 
 resource "traceforce_datalake" "test2" {
   project_id            = traceforce_project.test2.id
-  type                  = "BigQuery"
+  type                  = "bigquery"
   name                  = "` + datalakeName2 + `"
   environment_native_id = "test-project-id-2"
   region                = "us-central1"
 }
 
 resource "traceforce_source_app" "test2" {
-  project_id = traceforce_project.test2.id
-  type       = "Salesforce"
-  name       = "` + sourceAppName2 + `"
+  hosting_environment_id = traceforce_project.test2.id
+  type                   = "salesforce"
+  name                   = "` + sourceAppName2 + `"
 }
 
 resource "traceforce_source_app_datalake_link" "test" {
@@ -198,3 +186,229 @@ resource "traceforce_source_app_datalake_link" "test" {
 		},
 	})
 }
+
+func TestSourceAppDatalakeLinkResourceImportState(t *testing.T) {
+	f := newFakeAPI(t)
+	sourceAppID := uuid.New().String()
+	datalakeID := uuid.New().String()
+	f.add(
+		traceforce.SourceAppDatalakeLink{ID: "link-1", SourceAppID: sourceAppID, DatalakeID: uuid.New().String()},
+		traceforce.SourceAppDatalakeLink{ID: "link-2", SourceAppID: sourceAppID, DatalakeID: datalakeID},
+	)
+
+	testCases := map[string]struct {
+		importID    string
+		expectedID  string
+		expectError bool
+	}{
+		"link-id": {
+			importID:   "link-2",
+			expectedID: "link-2",
+		},
+		"source-app-and-datalake": {
+			importID:   sourceAppID + "/" + datalakeID,
+			expectedID: "link-2",
+		},
+		"not-linked": {
+			importID:    sourceAppID + "/" + uuid.New().String(),
+			expectError: true,
+		},
+		"unknown-source-app": {
+			importID:    uuid.New().String() + "/" + datalakeID,
+			expectError: true,
+		},
+		"missing-datalake": {
+			importID:    sourceAppID + "/",
+			expectError: true,
+		},
+		"too-many-parts": {
+			importID:    sourceAppID + "/" + datalakeID + "/extra",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := &sourceAppDatalakeLinkResource{client: f.client()}
+
+			if id := testImportState(t, r, testCase.importID, testCase.expectError); id != testCase.expectedID {
+				t.Errorf("expected id %q, got %q", testCase.expectedID, id)
+			}
+		})
+	}
+}
+func TestAccSourceAppDatalakeLinkResource_Identity(t *testing.T) {
+	projectName := "z-project-" + uuid.New().String()
+	datalakeName := "z-datalake-" + uuid.New().String()
+	sourceAppName := "z-source-app-" + uuid.New().String()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Resource identity requires Terraform 1.12 or later.
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceAppDatalakeLinkResourceConfig(projectName, datalakeName, sourceAppName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("traceforce_source_app_datalake_link.test", tfjsonpath.New("source_app_id")),
+					statecheck.ExpectIdentityValueMatchesState("traceforce_source_app_datalake_link.test", tfjsonpath.New("datalake_id")),
+				},
+			},
+			// ImportState testing by identity
+			{
+				ResourceName:    "traceforce_source_app_datalake_link.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func testAccSourceAppDatalakeLinkResourceConfig(projectName, datalakeName, sourceAppName string) string {
+	return providerConfig + `
+resource "traceforce_project" "test" {
+  name           = "` + projectName + `"
+  type           = "customer_managed"
+  cloud_provider = "gcp"
+  native_id      = "my-gcp-project"
+}
+
+resource "traceforce_datalake" "test" {
+  project_id            = traceforce_project.test.id
+  type                  = "bigquery"
+  name                  = "` + datalakeName + `"
+  environment_native_id = "test-project-id"
+  region                = "us-central1"
+}
+
+resource "traceforce_source_app" "test" {
+  hosting_environment_id = traceforce_project.test.id
+  type                   = "salesforce"
+  name                   = "` + sourceAppName + `"
+}
+
+resource "traceforce_source_app_datalake_link" "test" {
+  source_app_id = traceforce_source_app.test.id
+  datalake_id   = traceforce_datalake.test.id
+}
+`
+}
+
+func TestSourceAppDatalakeLinkResourceLifecycle(t *testing.T) {
+	f := newFakeAPI(t)
+	account := f.seed(t, "production")
+	datalake, err := f.client().CreateDatalake(t.Context(), traceforce.CreateDatalakeRequest{
+		HostingEnvironmentID: account.projectID,
+		Type:                 traceforce.DatalakeTypeBigQuery,
+		Name:                 "archive",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating datalake: %v", err)
+	}
+
+	testResourceLifecycle(t, f, NewSourceAppDatalakeLinkResource, lifecycleTestCase[sourceAppDatalakeLinkResourceModel]{
+		create: sourceAppDatalakeLinkResourceModel{
+			ID:                   types.StringUnknown(),
+			SourceAppID:          types.StringValue(account.sourceAppID),
+			DatalakeID:           types.StringValue(datalake.ID),
+			HostingEnvironmentID: types.StringUnknown(),
+			CreatedAt:            types.StringUnknown(),
+			UpdatedAt:            types.StringUnknown(),
+		},
+		check: func(t *testing.T, step string, state sourceAppDatalakeLinkResourceModel) {
+			if state.SourceAppID.ValueString() != account.sourceAppID || state.DatalakeID.ValueString() != datalake.ID {
+				t.Errorf("%s: expected a link between %q and %q, got %+v", step, account.sourceAppID, datalake.ID, state)
+			}
+			if state.HostingEnvironmentID.ValueString() != account.projectID {
+				t.Errorf("%s: expected hosting_environment_id %q, got %q", step, account.projectID, state.HostingEnvironmentID.ValueString())
+			}
+		},
+	})
+}
+
+func TestUnitSourceAppDatalakeLinkResource(t *testing.T) {
+	f := newFakeAPI(t)
+
+	config := func(datalake string) string {
+		return f.providerConfig() + `
+resource "traceforce_project" "test" {
+  name           = "production"
+  type           = "customer_managed"
+  cloud_provider = "gcp"
+  native_id      = "my-gcp-project"
+}
+
+resource "traceforce_datalake" "analytics" {
+  project_id            = traceforce_project.test.id
+  type                  = "bigquery"
+  name                  = "analytics"
+  environment_native_id = "my-gcp-project"
+  region                = "us-central1"
+}
+
+resource "traceforce_datalake" "warehouse" {
+  project_id            = traceforce_project.test.id
+  type                  = "bigquery"
+  name                  = "warehouse"
+  environment_native_id = "my-gcp-project"
+  region                = "us-central1"
+}
+
+resource "traceforce_source_app" "test" {
+  hosting_environment_id = traceforce_project.test.id
+  type                   = "salesforce"
+  name                   = "salesforce-prod"
+}
+
+resource "traceforce_source_app_datalake_link" "test" {
+  source_app_id = traceforce_source_app.test.id
+  datalake_id   = traceforce_datalake.` + datalake + `.id
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             f.checkDestroyed,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config("analytics"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("traceforce_source_app_datalake_link.test", "source_app_id", "traceforce_source_app.test", "id"),
+					resource.TestCheckResourceAttrPair("traceforce_source_app_datalake_link.test", "datalake_id", "traceforce_datalake.analytics", "id"),
+					resource.TestCheckResourceAttrPair("traceforce_source_app_datalake_link.test", "hosting_environment_id", "traceforce_project.test", "id"),
+					resource.TestCheckResourceAttrSet("traceforce_source_app_datalake_link.test", "id"),
+					resource.TestCheckResourceAttrSet("traceforce_source_app_datalake_link.test", "created_at"),
+					resource.TestCheckResourceAttrSet("traceforce_source_app_datalake_link.test", "updated_at"),
+				),
+			},
+			// ImportState testing by ID and by source app and datalake IDs
+			{
+				ResourceName:      "traceforce_source_app_datalake_link.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "traceforce_source_app_datalake_link.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccImportStateIDFunc("traceforce_source_app_datalake_link.test", "source_app_id", "datalake_id"),
+			},
+			// Changing the datalake replaces the link
+			{
+				Config: config("warehouse"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("traceforce_source_app_datalake_link.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttrPair("traceforce_source_app_datalake_link.test", "datalake_id", "traceforce_datalake.warehouse", "id"),
+			},
+		},
+	})
+}
@@ -0,0 +1,186 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &sourceAppDatalakeLinksDataSource{}
+	_ datasource.DataSourceWithConfigure = &sourceAppDatalakeLinksDataSource{}
+)
+
+func NewSourceAppDatalakeLinksDataSource() datasource.DataSource {
+	return &sourceAppDatalakeLinksDataSource{}
+}
+
+type sourceAppDatalakeLinksDataSource struct {
+	client *apiClient
+}
+
+// sourceAppDatalakeLinksDataSourceModel maps the data source schema data.
+type sourceAppDatalakeLinksDataSourceModel struct {
+	SourceAppID          types.String                 `tfsdk:"source_app_id"`
+	DatalakeID           types.String                 `tfsdk:"datalake_id"`
+	HostingEnvironmentID types.String                 `tfsdk:"hosting_environment_id"`
+	Links                []sourceAppDatalakeLinkModel `tfsdk:"links"`
+}
+
+// sourceAppDatalakeLinkModel maps source app datalake link schema data.
+type sourceAppDatalakeLinkModel struct {
+	ID                   types.String `tfsdk:"id"`
+	SourceAppID          types.String `tfsdk:"source_app_id"`
+	DatalakeID           types.String `tfsdk:"datalake_id"`
+	HostingEnvironmentID types.String `tfsdk:"hosting_environment_id"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	UpdatedAt            types.String `tfsdk:"updated_at"`
+}
+
+func (d *sourceAppDatalakeLinksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_app_datalake_links"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *sourceAppDatalakeLinksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *sourceAppDatalakeLinksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"source_app_id": schema.StringAttribute{
+				Description: "Filter links by source app ID.",
+				Optional:    true,
+			},
+			"datalake_id": schema.StringAttribute{
+				Description: "Filter links by datalake ID.",
+				Optional:    true,
+			},
+			"hosting_environment_id": schema.StringAttribute{
+				Description: "Filter links by hosting environment ID. If no filter is specified, returns all links.",
+				Optional:    true,
+			},
+			"links": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "System generated ID of the link",
+							Computed:    true,
+						},
+						"source_app_id": schema.StringAttribute{
+							Description: "ID of the linked source app",
+							Computed:    true,
+						},
+						"datalake_id": schema.StringAttribute{
+							Description: "ID of the linked datalake",
+							Computed:    true,
+						},
+						"hosting_environment_id": schema.StringAttribute{
+							Description: "ID of the hosting environment the link belongs to",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Date and time the link was created",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "Date and time the link was last updated",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *sourceAppDatalakeLinksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config sourceAppDatalakeLinksDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var links []traceforce.SourceAppDatalakeLink
+	var err error
+
+	// The API filters by one of source app or datalake, any other filter is
+	// applied below
+	switch {
+	case !config.SourceAppID.IsNull():
+		links, err = d.client.GetSourceAppDatalakeLinksBySourceApp(ctx, config.SourceAppID.ValueString())
+	case !config.DatalakeID.IsNull():
+		links, err = d.client.GetSourceAppDatalakeLinksByDatalake(ctx, config.DatalakeID.ValueString())
+	default:
+		links, err = d.client.GetSourceAppDatalakeLinks(ctx)
+	}
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error reading source app datalake links", err))
+		return
+	}
+
+	state := sourceAppDatalakeLinksDataSourceModel{
+		SourceAppID:          config.SourceAppID,
+		DatalakeID:           config.DatalakeID,
+		HostingEnvironmentID: config.HostingEnvironmentID,
+		Links:                []sourceAppDatalakeLinkModel{},
+	}
+
+	for _, link := range links {
+		if !config.SourceAppID.IsNull() && link.SourceAppID != config.SourceAppID.ValueString() {
+			continue
+		}
+		if !config.DatalakeID.IsNull() && link.DatalakeID != config.DatalakeID.ValueString() {
+			continue
+		}
+		if !config.HostingEnvironmentID.IsNull() && link.HostingEnvironmentID != config.HostingEnvironmentID.ValueString() {
+			continue
+		}
+
+		state.Links = append(state.Links, sourceAppDatalakeLinkModel{
+			ID:                   types.StringValue(link.ID),
+			SourceAppID:          types.StringValue(link.SourceAppID),
+			DatalakeID:           types.StringValue(link.DatalakeID),
+			HostingEnvironmentID: types.StringValue(link.HostingEnvironmentID),
+			CreatedAt:            types.StringValue(link.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt:            types.StringValue(link.UpdatedAt.Format(time.RFC3339)),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+func TestAccSourceAppDatalakeLinksDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing - all links
+			{
+				Config: providerConfig + `data "traceforce_source_app_datalake_links" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.traceforce_source_app_datalake_links.test", "links.0.id"),
+					resource.TestCheckResourceAttrSet("data.traceforce_source_app_datalake_links.test", "links.0.source_app_id"),
+					resource.TestCheckResourceAttrSet("data.traceforce_source_app_datalake_links.test", "links.0.datalake_id"),
+					resource.TestCheckResourceAttrSet("data.traceforce_source_app_datalake_links.test", "links.0.created_at"),
+				),
+			},
+			// Read testing - filtered by datalake
+			{
+				Config: providerConfig + `
+data "traceforce_source_app_datalake_links" "all" {}
+
+data "traceforce_source_app_datalake_links" "datalake_filtered" {
+  datalake_id = data.traceforce_source_app_datalake_links.all.links[0].datalake_id
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.traceforce_source_app_datalake_links.datalake_filtered", "links.0.datalake_id",
+						"data.traceforce_source_app_datalake_links.all", "links.0.datalake_id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestSourceAppDatalakeLinksDataSourceRead(t *testing.T) {
+	f := newFakeAPI(t)
+	sourceAppID := uuid.New().String()
+	datalakeID := uuid.New().String()
+	hostingEnvironmentID := uuid.New().String()
+	f.add(
+		traceforce.SourceAppDatalakeLink{ID: "link-1", SourceAppID: sourceAppID, DatalakeID: datalakeID, HostingEnvironmentID: hostingEnvironmentID},
+		traceforce.SourceAppDatalakeLink{ID: "link-2", SourceAppID: sourceAppID, DatalakeID: uuid.New().String(), HostingEnvironmentID: hostingEnvironmentID},
+		traceforce.SourceAppDatalakeLink{ID: "link-3", SourceAppID: uuid.New().String(), DatalakeID: datalakeID, HostingEnvironmentID: uuid.New().String()},
+	)
+
+	testCases := map[string]struct {
+		config      sourceAppDatalakeLinksDataSourceModel
+		expectedIDs []string
+	}{
+		"all": {
+			expectedIDs: []string{"link-1", "link-2", "link-3"},
+		},
+		"by-source-app": {
+			config:      sourceAppDatalakeLinksDataSourceModel{SourceAppID: types.StringValue(sourceAppID)},
+			expectedIDs: []string{"link-1", "link-2"},
+		},
+		"by-datalake": {
+			config:      sourceAppDatalakeLinksDataSourceModel{DatalakeID: types.StringValue(datalakeID)},
+			expectedIDs: []string{"link-1", "link-3"},
+		},
+		"by-hosting-environment": {
+			config:      sourceAppDatalakeLinksDataSourceModel{HostingEnvironmentID: types.StringValue(hostingEnvironmentID)},
+			expectedIDs: []string{"link-1", "link-2"},
+		},
+		"by-source-app-and-datalake": {
+			config:      sourceAppDatalakeLinksDataSourceModel{SourceAppID: types.StringValue(sourceAppID), DatalakeID: types.StringValue(datalakeID)},
+			expectedIDs: []string{"link-1"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp := f.readDataSource(t, NewSourceAppDatalakeLinksDataSource(), &testCase.config)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+
+			var state sourceAppDatalakeLinksDataSourceModel
+			if diags := resp.State.Get(t.Context(), &state); diags.HasError() {
+				t.Fatalf("unexpected state diagnostics: %v", diags)
+			}
+
+			var ids []string
+			for _, link := range state.Links {
+				ids = append(ids, link.ID.ValueString())
+			}
+			if !slices.Equal(ids, testCase.expectedIDs) {
+				t.Errorf("expected links %v, got %v", testCase.expectedIDs, ids)
+			}
+		})
+	}
+}
+func TestUnitSourceAppDatalakeLinksDataSource(t *testing.T) {
+	f := newFakeAPI(t)
+	production := f.seed(t, "production")
+	staging := f.seed(t, "staging")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: f.providerConfig() + `
+data "traceforce_source_app_datalake_links" "all" {}
+
+data "traceforce_source_app_datalake_links" "source_app_filtered" {
+  source_app_id = "` + staging.sourceAppID + `"
+}
+
+data "traceforce_source_app_datalake_links" "datalake_filtered" {
+  datalake_id = "` + production.datalakeID + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.traceforce_source_app_datalake_links.all", "links.#", "2"),
+					resource.TestCheckResourceAttr("data.traceforce_source_app_datalake_links.source_app_filtered", "links.#", "1"),
+					resource.TestCheckResourceAttr("data.traceforce_source_app_datalake_links.source_app_filtered", "links.0.id", staging.linkID),
+					resource.TestCheckResourceAttr("data.traceforce_source_app_datalake_links.source_app_filtered", "links.0.datalake_id", staging.datalakeID),
+					resource.TestCheckResourceAttr("data.traceforce_source_app_datalake_links.datalake_filtered", "links.#", "1"),
+					resource.TestCheckResourceAttr("data.traceforce_source_app_datalake_links.datalake_filtered", "links.0.id", production.linkID),
+					resource.TestCheckResourceAttr("data.traceforce_source_app_datalake_links.datalake_filtered", "links.0.source_app_id", production.sourceAppID),
+				),
+			},
+		},
+	})
+}
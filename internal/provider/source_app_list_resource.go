@@ -0,0 +1,85 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &sourceAppListResource{}
+	_ list.ListResourceWithConfigure = &sourceAppListResource{}
+)
+
+// NewSourceAppListResource creates a new source app list resource.
+func NewSourceAppListResource() list.ListResource {
+	return &sourceAppListResource{}
+}
+
+// sourceAppListResource lists existing source apps so that terraform query can
+// generate configuration and import blocks for them. It shares its metadata
+// and configuration with the source app resource.
+type sourceAppListResource struct {
+	sourceAppResource
+}
+
+// sourceAppListModel maps the source app list configuration.
+type sourceAppListModel struct {
+	HostingEnvironmentId types.String `tfsdk:"hosting_environment_id"`
+}
+
+// ListResourceConfigSchema defines the schema for list blocks.
+func (r *sourceAppListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the source apps of the account.",
+		Attributes: map[string]schema.Attribute{
+			"hosting_environment_id": schema.StringAttribute{
+				Description: "Only list source apps in this hosting environment.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// List streams the source apps matching the list configuration.
+func (r *sourceAppListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config sourceAppListModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var sourceApps []traceforce.SourceApp
+	var err error
+	if !config.HostingEnvironmentId.IsNull() {
+		sourceApps, err = r.client.GetSourceAppsByHostingEnvironment(ctx, config.HostingEnvironmentId.ValueString())
+	} else {
+		sourceApps, err = r.client.GetSourceApps(ctx)
+	}
+	if err != nil {
+		stream.Results = listError("Error reading source apps", err)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, sourceApps, func(sourceApp traceforce.SourceApp) (string, any, any) {
+		return sourceApp.Name, idIdentityModel{ID: types.StringValue(sourceApp.ID)}, sourceAppResourceModel{
+			ID:                   types.StringValue(sourceApp.ID),
+			HostingEnvironmentId: types.StringValue(sourceApp.HostingEnvironmentID),
+			Type:                 types.StringValue(string(sourceApp.Type)),
+			Name:                 types.StringValue(sourceApp.Name),
+			Status:               types.StringValue(string(sourceApp.Status)),
+			CreatedAt:            types.StringValue(sourceApp.CreatedAt.Format(time.RFC3339)),
+			UpdatedAt:            types.StringValue(sourceApp.UpdatedAt.Format(time.RFC3339)),
+		}
+	})
+}
@@ -6,13 +6,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	traceforce "github.com/traceforce/traceforce-go-sdk"
 )
@@ -22,6 +25,7 @@ var (
 	_ resource.Resource                = &sourceAppResource{}
 	_ resource.ResourceWithConfigure   = &sourceAppResource{}
 	_ resource.ResourceWithImportState = &sourceAppResource{}
+	_ resource.ResourceWithIdentity    = &sourceAppResource{}
 )
 
 // NewSourceAppResource creates a new source app resource.
@@ -31,7 +35,7 @@ func NewSourceAppResource() resource.Resource {
 
 // sourceAppResource is the resource implementation.
 type sourceAppResource struct {
-	client *traceforce.Client
+	client *apiClient
 }
 
 // sourceAppResourceModel maps source_apps schema data.
@@ -57,7 +61,7 @@ func (r *sourceAppResource) Configure(ctx context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*traceforce.Client)
+	client, ok := req.ProviderData.(*apiClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
@@ -86,6 +90,9 @@ func (r *sourceAppResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: fmt.Sprintf("Type of source app. Currently supported: %s.",
 					traceforce.SourceAppTypeSalesforce),
 				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(stringValues(traceforce.SourceAppTypeSalesforce)...),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -131,6 +138,11 @@ func (r *sourceAppResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// IdentitySchema defines the identity schema for the resource.
+func (r *sourceAppResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("source app")
+}
+
 func (r *sourceAppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan sourceAppResourceModel
 
@@ -146,9 +158,9 @@ func (r *sourceAppResource) Create(ctx context.Context, req resource.CreateReque
 		Name:                 plan.Name.ValueString(),
 	}
 
-	sourceApp, err := r.client.CreateSourceApp(input)
+	sourceApp, err := r.client.CreateSourceApp(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating source app", err.Error())
+		resp.Diagnostics.Append(apiNameErrorDiagnostic("Error creating source app", err))
 		return
 	}
 
@@ -164,6 +176,7 @@ func (r *sourceAppResource) Create(ctx context.Context, req resource.CreateReque
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentityModel{ID: plan.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -178,9 +191,12 @@ func (r *sourceAppResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	sourceApp, err := r.client.GetSourceApp(state.ID.ValueString())
+	sourceApp, err := r.client.GetSourceApp(ctx, state.ID.ValueString())
+	if removeIfNotFound(ctx, err, resp, "source app", state.ID.ValueString()) {
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading source app", err.Error())
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error reading source app", err))
 		return
 	}
 
@@ -196,6 +212,7 @@ func (r *sourceAppResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentityModel{ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -216,9 +233,9 @@ func (r *sourceAppResource) Update(ctx context.Context, req resource.UpdateReque
 		Name: &name,
 	}
 
-	sourceApp, err := r.client.UpdateSourceApp(plan.ID.ValueString(), input)
+	sourceApp, err := r.client.UpdateSourceApp(ctx, plan.ID.ValueString(), input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating source app", err.Error())
+		resp.Diagnostics.Append(apiNameErrorDiagnostic("Error updating source app", err))
 		return
 	}
 
@@ -234,6 +251,7 @@ func (r *sourceAppResource) Update(ctx context.Context, req resource.UpdateReque
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, idIdentityModel{ID: plan.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -248,14 +266,48 @@ func (r *sourceAppResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	err := r.client.DeleteSourceApp(state.ID.ValueString())
+	err := r.client.DeleteSourceApp(ctx, state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting source app", err.Error())
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error deleting source app", err))
 		return
 	}
 }
 
+// ImportState imports a source app by its ID, or by its name within a project
+// in the form <project_id>/<name>.
 func (r *sourceAppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import source app by id
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	projectID, name, ok := strings.Cut(req.ID, "/")
+	if !ok {
+		// Import source app by id, given either as the import ID or as
+		// the identity
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+	if projectID == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a source app ID or an import identifier with format <project_id>/<name>, got: %q", req.ID),
+		)
+		return
+	}
+
+	sourceApps, err := r.client.GetSourceAppsByHostingEnvironment(ctx, projectID)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic("Error reading source apps by hosting environment", err))
+		return
+	}
+
+	var matches []traceforce.SourceApp
+	for _, candidate := range sourceApps {
+		if candidate.Name == name {
+			matches = append(matches, candidate)
+		}
+	}
+
+	description := fmt.Sprintf("name %q in project %q", name, projectID)
+	match, ok := lookupOne(matches, func(sourceApp traceforce.SourceApp) string { return sourceApp.ID }, "source app", description, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), match.ID)...)
 }
@@ -4,10 +4,13 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
 )
 
 func TestAccSourceAppResource(t *testing.T) {
@@ -26,20 +29,20 @@ func TestAccSourceAppResource(t *testing.T) {
 				Config: providerConfig + `
 resource "traceforce_project" "test" {
   name           = "` + projectName + `"
-  type           = "Customer Managed"
-  cloud_provider = "GCP"
+  type           = "customer_managed"
+  cloud_provider = "gcp"
   native_id      = "my-gcp-project"
 }
 
 resource "traceforce_source_app" "test" {
   hosting_environment_id = traceforce_project.test.id
-  type                   = "Salesforce"
+  type                   = "salesforce"
   name                   = "` + sourceAppName + `"
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify attributes
-					resource.TestCheckResourceAttr("traceforce_source_app.test", "type", "Salesforce"),
+					resource.TestCheckResourceAttr("traceforce_source_app.test", "type", "salesforce"),
 					resource.TestCheckResourceAttr("traceforce_source_app.test", "name", sourceAppName),
 					resource.TestCheckResourceAttrSet("traceforce_source_app.test", "hosting_environment_id"),
 					// Verify dynamic values have any value set in the state.
@@ -57,29 +60,204 @@ resource "traceforce_source_app" "test" {
 				// The updated_at attribute may not match during import
 				ImportStateVerifyIgnore: []string{"updated_at"},
 			},
+			// ImportState testing by project ID and name
+			{
+				ResourceName:            "traceforce_source_app.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccImportStateIDFunc("traceforce_source_app.test", "hosting_environment_id", "name"),
+				ImportStateVerifyIgnore: []string{"updated_at"},
+			},
 			// Update and Read testing
 			{
 				Config: providerConfig + `
 resource "traceforce_project" "test" {
   name           = "` + projectName + `"
-  type           = "Customer Managed"
-  cloud_provider = "GCP"
+  type           = "customer_managed"
+  cloud_provider = "gcp"
   native_id      = "my-gcp-project"
 }
 
 resource "traceforce_source_app" "test" {
   hosting_environment_id = traceforce_project.test.id
-  type                   = "Salesforce"
+  type                   = "salesforce"
   name                   = "` + sourceAppName + `-updated"
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify name was updated
 					resource.TestCheckResourceAttr("traceforce_source_app.test", "name", sourceAppName+"-updated"),
-					resource.TestCheckResourceAttr("traceforce_source_app.test", "type", "Salesforce"),
+					resource.TestCheckResourceAttr("traceforce_source_app.test", "type", "salesforce"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
+
+func TestSourceAppResourceImportState(t *testing.T) {
+	f := newFakeAPI(t)
+	projectID := uuid.New().String()
+	f.add(
+		traceforce.SourceApp{ID: "source-app-1", HostingEnvironmentID: projectID, Name: "salesforce-prod"},
+		traceforce.SourceApp{ID: "source-app-2", HostingEnvironmentID: projectID, Name: "salesforce-prod"},
+		traceforce.SourceApp{ID: "source-app-3", HostingEnvironmentID: projectID, Name: "hubspot-prod"},
+	)
+
+	testCases := map[string]struct {
+		importID    string
+		expectedID  string
+		expectError bool
+	}{
+		"id": {
+			importID:   "source-app-1",
+			expectedID: "source-app-1",
+		},
+		"project-and-name": {
+			importID:   projectID + "/hubspot-prod",
+			expectedID: "source-app-3",
+		},
+		"name-ambiguous": {
+			importID:    projectID + "/salesforce-prod",
+			expectError: true,
+		},
+		"unknown-project": {
+			importID:    uuid.New().String() + "/hubspot-prod",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := &sourceAppResource{client: f.client()}
+
+			if id := testImportState(t, r, testCase.importID, testCase.expectError); id != testCase.expectedID {
+				t.Errorf("expected id %q, got %q", testCase.expectedID, id)
+			}
+		})
+	}
+}
+func TestSourceAppResourceLifecycle(t *testing.T) {
+	f := newFakeAPI(t)
+	projectID := f.seed(t, "production").projectID
+
+	testResourceLifecycle(t, f, NewSourceAppResource, lifecycleTestCase[sourceAppResourceModel]{
+		create: sourceAppResourceModel{
+			ID:                   types.StringUnknown(),
+			HostingEnvironmentId: types.StringValue(projectID),
+			Type:                 types.StringValue(string(traceforce.SourceAppTypeSalesforce)),
+			Name:                 types.StringValue("salesforce-prod"),
+			Status:               types.StringUnknown(),
+			CreatedAt:            types.StringUnknown(),
+			UpdatedAt:            types.StringUnknown(),
+		},
+		update: func(state sourceAppResourceModel) sourceAppResourceModel {
+			state.Name = types.StringValue("salesforce-updated")
+			state.UpdatedAt = types.StringUnknown()
+			return state
+		},
+		check: func(t *testing.T, step string, state sourceAppResourceModel) {
+			expectedName := map[string]string{"create": "salesforce-prod", "update": "salesforce-updated"}[step]
+			if state.Name.ValueString() != expectedName {
+				t.Errorf("%s: expected name %q, got %q", step, expectedName, state.Name.ValueString())
+			}
+			if state.HostingEnvironmentId.ValueString() != projectID || state.Status.ValueString() != "pending" {
+				t.Errorf("%s: expected a pending source app in project %q, got %q in %q", step, projectID, state.Status.ValueString(), state.HostingEnvironmentId.ValueString())
+			}
+		},
+	})
+}
+
+func TestUnitSourceAppResource(t *testing.T) {
+	f := newFakeAPI(t)
+
+	config := func(name string) string {
+		return f.providerConfig() + `
+resource "traceforce_project" "test" {
+  name           = "production"
+  type           = "customer_managed"
+  cloud_provider = "gcp"
+  native_id      = "my-gcp-project"
+}
+
+resource "traceforce_source_app" "test" {
+  hosting_environment_id = traceforce_project.test.id
+  type                   = "salesforce"
+  name                   = "` + name + `"
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             f.checkDestroyed,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config("salesforce-prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("traceforce_source_app.test", "hosting_environment_id", "traceforce_project.test", "id"),
+					resource.TestCheckResourceAttr("traceforce_source_app.test", "type", "salesforce"),
+					resource.TestCheckResourceAttr("traceforce_source_app.test", "name", "salesforce-prod"),
+					resource.TestCheckResourceAttr("traceforce_source_app.test", "status", "pending"),
+					resource.TestCheckResourceAttrSet("traceforce_source_app.test", "id"),
+					resource.TestCheckResourceAttrSet("traceforce_source_app.test", "created_at"),
+					resource.TestCheckResourceAttrSet("traceforce_source_app.test", "updated_at"),
+				),
+			},
+			// ImportState testing by ID and by project ID and name
+			{
+				ResourceName:      "traceforce_source_app.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "traceforce_source_app.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccImportStateIDFunc("traceforce_source_app.test", "hosting_environment_id", "name"),
+			},
+			// Update and Read testing
+			{
+				Config: config("salesforce-prod-updated"),
+				Check:  resource.TestCheckResourceAttr("traceforce_source_app.test", "name", "salesforce-prod-updated"),
+			},
+		},
+	})
+}
+
+func TestUnitSourceAppResourceCreateConflict(t *testing.T) {
+	f := newFakeAPI(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             f.checkDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: f.providerConfig() + `
+resource "traceforce_project" "test" {
+  name           = "production"
+  type           = "customer_managed"
+  cloud_provider = "gcp"
+  native_id      = "my-gcp-project"
+}
+
+resource "traceforce_source_app" "first" {
+  hosting_environment_id = traceforce_project.test.id
+  type                   = "salesforce"
+  name                   = "salesforce-prod"
+}
+
+resource "traceforce_source_app" "second" {
+  hosting_environment_id = traceforce_project.test.id
+  type                   = "salesforce"
+  name                   = "salesforce-prod"
+}
+`,
+				ExpectError: regexp.MustCompile(`Error creating source app`),
+			},
+		},
+	})
+}
@@ -25,11 +25,12 @@ func NewSourceAppsDataSource() datasource.DataSource {
 }
 
 type sourceAppsDataSource struct {
-	client *traceforce.Client
+	client *apiClient
 }
 
 // sourceAppsDataSourceModel maps the data source schema data.
 type sourceAppsDataSourceModel struct {
+	Filter               *filterModel      `tfsdk:"filter"`
 	HostingEnvironmentId types.String      `tfsdk:"hosting_environment_id"`
 	SourceApps           []sourceAppsModel `tfsdk:"source_apps"`
 }
@@ -57,11 +58,11 @@ func (d *sourceAppsDataSource) Configure(_ context.Context, req datasource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*traceforce.Client)
+	client, ok := req.ProviderData.(*apiClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *traceforce.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.apiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -118,6 +119,11 @@ func (d *sourceAppsDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": filterBlock("source app",
+				stringValues(traceforce.SourceAppStatusPending, traceforce.SourceAppStatusDeployed, traceforce.SourceAppStatusDisconnected, traceforce.SourceAppStatusConnected),
+				stringValues(traceforce.SourceAppTypeSalesforce)),
+		},
 	}
 }
 
@@ -130,29 +136,52 @@ func (d *sourceAppsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
+	filter, diags := parseFilter(config.Filter)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var sourceApps []traceforce.SourceApp
 	var err error
 
 	if !config.HostingEnvironmentId.IsNull() {
 		// Get source apps filtered by hosting environment ID
-		sourceApps, err = d.client.GetSourceAppsByHostingEnvironment(config.HostingEnvironmentId.ValueString())
+		sourceApps, err = d.client.GetSourceAppsByHostingEnvironment(ctx, config.HostingEnvironmentId.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Error reading source apps by hosting environment", err.Error())
+			resp.Diagnostics.Append(apiErrorDiagnostic("Error reading source apps by hosting environment", err))
 			return
 		}
 	} else {
 		// Get all source apps
-		sourceApps, err = d.client.GetSourceApps()
+		sourceApps, err = d.client.GetSourceApps(ctx)
 		if err != nil {
-			resp.Diagnostics.AddError("Error reading source apps", err.Error())
+			resp.Diagnostics.Append(apiErrorDiagnostic("Error reading source apps", err))
 			return
 		}
 	}
 
-	var state sourceAppsDataSourceModel
-	state.HostingEnvironmentId = config.HostingEnvironmentId
+	// Source apps only reference their hosting environment, so its cloud provider has to be
+	// looked up to filter by cloud provider
+	var cloudProviders map[string]string
+	if filter.byCloudProvider() {
+		cloudProviders, err = projectCloudProviders(ctx, d.client)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic("Error reading hosting environments", err))
+			return
+		}
+	}
+
+	state := sourceAppsDataSourceModel{
+		Filter:               config.Filter,
+		HostingEnvironmentId: config.HostingEnvironmentId,
+		SourceApps:           []sourceAppsModel{},
+	}
 
 	for _, sourceApp := range sourceApps {
+		if !filter.matches(sourceApp.Name, string(sourceApp.Status), string(sourceApp.Type), cloudProviders[sourceApp.HostingEnvironmentID], sourceApp.CreatedAt) {
+			continue
+		}
 		state.SourceApps = append(state.SourceApps, sourceAppsModel{
 			ID:                   types.StringValue(sourceApp.ID),
 			HostingEnvironmentId: types.StringValue(sourceApp.HostingEnvironmentID),
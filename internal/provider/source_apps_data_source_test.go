@@ -20,9 +20,9 @@ func TestAccSourceAppsDataSource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify the first source app to ensure all attributes are set
 					resource.TestCheckResourceAttr("data.traceforce_source_apps.test", "source_apps.0.name", "sales-crm"),
-					resource.TestCheckResourceAttr("data.traceforce_source_apps.test", "source_apps.0.type", "Salesforce"),
+					resource.TestCheckResourceAttr("data.traceforce_source_apps.test", "source_apps.0.type", "salesforce"),
 					resource.TestCheckResourceAttr("data.traceforce_source_apps.test", "source_apps.0.datalake_id", "datalake-1"),
-					resource.TestCheckResourceAttr("data.traceforce_source_apps.test", "source_apps.0.status", "Connected"),
+					resource.TestCheckResourceAttr("data.traceforce_source_apps.test", "source_apps.0.status", "connected"),
 					resource.TestCheckResourceAttrSet("data.traceforce_source_apps.test", "source_apps.0.id"),
 					resource.TestCheckResourceAttrSet("data.traceforce_source_apps.test", "source_apps.0.created_at"),
 					resource.TestCheckResourceAttrSet("data.traceforce_source_apps.test", "source_apps.0.updated_at"),
@@ -44,3 +44,32 @@ data "traceforce_source_apps" "datalake_filtered" {
 		},
 	})
 }
+
+func TestUnitSourceAppsDataSource(t *testing.T) {
+	f := newFakeAPI(t)
+	production := f.seed(t, "production")
+	staging := f.seed(t, "staging")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: f.providerConfig() + `
+data "traceforce_source_apps" "all" {}
+
+data "traceforce_source_apps" "hosting_environment_filtered" {
+  hosting_environment_id = "` + staging.projectID + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.traceforce_source_apps.all", "source_apps.#", "2"),
+					resource.TestCheckResourceAttr("data.traceforce_source_apps.all", "source_apps.0.id", production.sourceAppID),
+					resource.TestCheckResourceAttr("data.traceforce_source_apps.hosting_environment_filtered", "source_apps.#", "1"),
+					resource.TestCheckResourceAttr("data.traceforce_source_apps.hosting_environment_filtered", "source_apps.0.id", staging.sourceAppID),
+					resource.TestCheckResourceAttr("data.traceforce_source_apps.hosting_environment_filtered", "source_apps.0.name", "staging-salesforce"),
+				),
+			},
+		},
+	})
+}
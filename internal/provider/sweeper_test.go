@@ -0,0 +1,312 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+// sweepNamePattern matches the names of the objects created by acceptance
+// tests: z-, an optional label such as project-, and a random UUID.
+var sweepNamePattern = regexp.MustCompile(`^z-(?:[a-z0-9]+-)*[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+// Sweepers delete the objects leaked by aborted acceptance tests. Run them with
+//
+//	go test ./internal/provider -v -sweep=all
+//
+// Links are swept first, then source apps and datalakes, and projects last, so
+// that no object is deleted while other objects still refer to it.
+func init() {
+	resource.AddTestSweepers("traceforce_source_app_datalake_link", &resource.Sweeper{
+		Name: "traceforce_source_app_datalake_link",
+		F:    sweepSourceAppDatalakeLinks,
+	})
+	resource.AddTestSweepers("traceforce_datalake", &resource.Sweeper{
+		Name:         "traceforce_datalake",
+		Dependencies: []string{"traceforce_source_app_datalake_link"},
+		F:            sweepDatalakes,
+	})
+	resource.AddTestSweepers("traceforce_source_app", &resource.Sweeper{
+		Name:         "traceforce_source_app",
+		Dependencies: []string{"traceforce_source_app_datalake_link"},
+		F:            sweepSourceApps,
+	})
+	resource.AddTestSweepers("traceforce_project", &resource.Sweeper{
+		Name:         "traceforce_project",
+		Dependencies: []string{"traceforce_datalake", "traceforce_source_app"},
+		F:            sweepProjects,
+	})
+}
+
+// sweeperClient creates an API client for the account the acceptance tests
+// run against.
+func sweeperClient() (*apiClient, error) {
+	apiKey := os.Getenv("TRACEFORCE_API_KEY")
+	if apiKey == "" {
+		return nil, errors.New("the TRACEFORCE_API_KEY environment variable must be set to run sweepers")
+	}
+
+	return newAPIClient(apiKey, os.Getenv("TRACEFORCE_ENDPOINT"), apiClientOptions{
+		Retry: defaultRetryPolicy(),
+	})
+}
+
+// sweepObjects deletes the given objects, ignoring those already gone, and
+// returns the errors of the deletions that failed.
+func sweepObjects[T any](ctx context.Context, objects []T, kind string, describe func(T) (id string, swept bool), deleteObject func(ctx context.Context, id string) error) error {
+	var errs []error
+	for _, object := range objects {
+		id, swept := describe(object)
+		if !swept {
+			continue
+		}
+		if err := deleteObject(ctx, id); err != nil && !isNotFound(err) {
+			errs = append(errs, fmt.Errorf("deleting %s %s: %w", kind, id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sweepSourceAppDatalakeLinks(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	datalakes, err := client.GetDatalakes(ctx)
+	if err != nil {
+		return fmt.Errorf("reading datalakes: %w", err)
+	}
+	sourceApps, err := client.GetSourceApps(ctx)
+	if err != nil {
+		return fmt.Errorf("reading source apps: %w", err)
+	}
+	links, err := client.GetSourceAppDatalakeLinks(ctx)
+	if err != nil {
+		return fmt.Errorf("reading source app datalake links: %w", err)
+	}
+
+	// Links are swept with the datalakes and source apps they link
+	sweptIDs := make(map[string]bool)
+	for _, datalake := range datalakes {
+		if sweepNamePattern.MatchString(datalake.Name) {
+			sweptIDs[datalake.ID] = true
+		}
+	}
+	for _, sourceApp := range sourceApps {
+		if sweepNamePattern.MatchString(sourceApp.Name) {
+			sweptIDs[sourceApp.ID] = true
+		}
+	}
+
+	return sweepObjects(ctx, links, "source app datalake link", func(link traceforce.SourceAppDatalakeLink) (string, bool) {
+		return link.ID, sweptIDs[link.SourceAppID] || sweptIDs[link.DatalakeID]
+	}, client.DeleteSourceAppDatalakeLink)
+}
+
+func sweepDatalakes(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	datalakes, err := client.GetDatalakes(ctx)
+	if err != nil {
+		return fmt.Errorf("reading datalakes: %w", err)
+	}
+
+	return sweepObjects(ctx, datalakes, "datalake", func(datalake traceforce.Datalake) (string, bool) {
+		return datalake.ID, sweepNamePattern.MatchString(datalake.Name)
+	}, client.DeleteDatalake)
+}
+
+func sweepSourceApps(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	sourceApps, err := client.GetSourceApps(ctx)
+	if err != nil {
+		return fmt.Errorf("reading source apps: %w", err)
+	}
+
+	return sweepObjects(ctx, sourceApps, "source app", func(sourceApp traceforce.SourceApp) (string, bool) {
+		return sourceApp.ID, sweepNamePattern.MatchString(sourceApp.Name)
+	}, client.DeleteSourceApp)
+}
+
+func sweepProjects(_ string) error {
+	ctx := context.Background()
+	client, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	projects, err := client.GetHostingEnvironments(ctx)
+	if err != nil {
+		return fmt.Errorf("reading hosting environments: %w", err)
+	}
+
+	return sweepObjects(ctx, projects, "hosting environment", func(project traceforce.HostingEnvironment) (string, bool) {
+		return project.ID, sweepNamePattern.MatchString(project.Name)
+	}, client.DeleteHostingEnvironment)
+}
+
+func TestSweepers(t *testing.T) {
+	f := newFakeAPI(t)
+	t.Setenv("TRACEFORCE_API_KEY", fakeAPIKey)
+	t.Setenv("TRACEFORCE_ENDPOINT", f.server.URL)
+
+	ctx := t.Context()
+	client := f.client()
+	kept := f.seed(t, "production")
+
+	// Objects whose name only starts with z- were not created by acceptance
+	// tests.
+	prefixOnly := f.seed(t, "z-staging")
+
+	// A leaked project with a leaked datalake, and a datalake that is kept
+	// because only its project was created by an acceptance test.
+	leakedProject, err := client.CreateHostingEnvironment(ctx, traceforce.CreateHostingEnvironmentRequest{
+		Name:          "z-project-" + uuid.New().String(),
+		Type:          traceforce.HostingEnvironmentTypeCustomerManaged,
+		CloudProvider: traceforce.CloudProviderGCP,
+		NativeID:      "z-project",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %v", err)
+	}
+	var keptDatalakeIDs []string
+	for _, name := range []string{"z-datalake-" + uuid.New().String(), "warehouse"} {
+		datalake, err := client.CreateDatalake(ctx, traceforce.CreateDatalakeRequest{
+			HostingEnvironmentID: leakedProject.ID,
+			Type:                 traceforce.DatalakeTypeBigQuery,
+			Name:                 name,
+			EnvironmentNativeID:  "z-project",
+			Region:               "us-central1",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error creating datalake: %v", err)
+		}
+		if name == "warehouse" {
+			keptDatalakeIDs = append(keptDatalakeIDs, datalake.ID)
+		}
+	}
+
+	// A leaked source app linked to a datalake that is kept
+	sourceApp, err := client.CreateSourceApp(ctx, traceforce.CreateSourceAppRequest{
+		HostingEnvironmentID: kept.projectID,
+		Type:                 traceforce.SourceAppTypeSalesforce,
+		Name:                 "z-sourceapp-" + uuid.New().String(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating source app: %v", err)
+	}
+	if _, err := client.CreateSourceAppDatalakeLink(ctx, traceforce.CreateSourceAppDatalakeLinkRequest{
+		SourceAppID: sourceApp.ID,
+		DatalakeID:  kept.datalakeID,
+	}); err != nil {
+		t.Fatalf("unexpected error creating link: %v", err)
+	}
+
+	// Sweepers run in dependency order.
+	for _, sweep := range []func(string) error{sweepSourceAppDatalakeLinks, sweepDatalakes, sweepSourceApps, sweepProjects} {
+		if err := sweep(""); err != nil {
+			t.Fatalf("unexpected sweeper error: %v", err)
+		}
+	}
+
+	projects, err := client.GetHostingEnvironments(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectSweptIDs(t, "projects", projects, func(project traceforce.HostingEnvironment) string { return project.ID },
+		kept.projectID, prefixOnly.projectID)
+
+	datalakes, err := client.GetDatalakes(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectSweptIDs(t, "datalakes", datalakes, func(datalake traceforce.Datalake) string { return datalake.ID },
+		append(keptDatalakeIDs, kept.datalakeID, prefixOnly.datalakeID)...)
+
+	sourceApps, err := client.GetSourceApps(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectSweptIDs(t, "source apps", sourceApps, func(sourceApp traceforce.SourceApp) string { return sourceApp.ID },
+		kept.sourceAppID, prefixOnly.sourceAppID)
+
+	links, err := client.GetSourceAppDatalakeLinks(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectSweptIDs(t, "links", links, func(link traceforce.SourceAppDatalakeLink) string { return link.ID },
+		kept.linkID, prefixOnly.linkID)
+}
+
+// expectSweptIDs fails the test unless exactly the objects with the expected
+// IDs were kept by the sweepers.
+func expectSweptIDs[T any](t *testing.T, kind string, objects []T, id func(T) string, expected ...string) {
+	t.Helper()
+
+	got := make([]string, 0, len(objects))
+	for _, object := range objects {
+		got = append(got, id(object))
+	}
+	slices.Sort(got)
+	slices.Sort(expected)
+	if !slices.Equal(got, expected) {
+		t.Errorf("expected %s %v to be kept, got %v", kind, expected, got)
+	}
+}
+
+func TestSweepNamePattern(t *testing.T) {
+	testCases := map[string]struct {
+		name     string
+		expected bool
+	}{
+		"uuid":              {name: "z-" + uuid.New().String(), expected: true},
+		"label-and-uuid":    {name: "z-project-" + uuid.New().String(), expected: true},
+		"labels-and-uuid":   {name: "z-events-subscription-" + uuid.New().String(), expected: true},
+		"no-uuid":           {name: "z-staging"},
+		"invalid-uuid":      {name: "z-project-not-a-uuid"},
+		"no-prefix":         {name: "production-" + uuid.New().String()},
+		"suffix-after-uuid": {name: "z-project-" + uuid.New().String() + "-old"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := sweepNamePattern.MatchString(testCase.name); got != testCase.expected {
+				t.Errorf("expected %q to match: %t, got %t", testCase.name, testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestSweepersRequireAPIKey(t *testing.T) {
+	t.Setenv("TRACEFORCE_API_KEY", "")
+
+	if err := sweepProjects(""); err == nil {
+		t.Error("expected sweeping without an API key to fail")
+	}
+}
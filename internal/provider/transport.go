@@ -0,0 +1,98 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// apiAttempt is a single attempt at an API call made through the SDK client.
+type apiAttempt struct {
+	number int
+
+	// sent reports whether the SDK sent a request for the attempt.
+	sent bool
+
+	// resp is the response the API answered the attempt with, or nil if no
+	// request was sent or it failed before a response was received. Its body
+	// has already been read into body.
+	resp *http.Response
+	body []byte
+}
+
+// attemptContextKey is the context key of the apiAttempt a request belongs to.
+type attemptContextKey struct{}
+
+// withAttempt returns a copy of ctx carrying attempt, for sdkTransport to
+// record the request and response of the attempt.
+func withAttempt(ctx context.Context, attempt *apiAttempt) context.Context {
+	return context.WithValue(ctx, attemptContextKey{}, attempt)
+}
+
+// err returns the error an attempt failed with. Error responses are returned
+// as *apiError.
+func (a *apiAttempt) err(sdkErr error) error {
+	if a.resp != nil && a.resp.StatusCode >= 400 {
+		return &apiError{
+			StatusCode: a.resp.StatusCode,
+			Body:       string(a.body),
+			RequestID:  a.resp.Header.Get(requestIDHeader),
+		}
+	}
+
+	return sdkErr
+}
+
+// sdkTransport is the HTTP transport of the SDK client. It logs the requests
+// of API call attempts and records their responses.
+type sdkTransport struct {
+	base http.RoundTripper
+}
+
+func (t *sdkTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	attempt, ok := ctx.Value(attemptContextKey{}).(*apiAttempt)
+	if !ok {
+		return t.base.RoundTrip(req)
+	}
+	attempt.sent = true
+
+	var payload []byte
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		payload, err = io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	logRequestBody(ctx, req, payload)
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	logRequest(ctx, req, attempt.number, resp, err, time.Since(start))
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the body eagerly, so that it can be logged and reported in errors.
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	logResponseBody(ctx, resp, body)
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	attempt.resp = resp
+	attempt.body = body
+	return resp, nil
+}
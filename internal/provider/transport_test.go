@@ -0,0 +1,94 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSDKTransport(t *testing.T) {
+	testCases := map[string]struct {
+		attempt *apiAttempt
+	}{
+		"attempt": {
+			attempt: &apiAttempt{number: 1},
+		},
+		"unrelated-request": {},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`[]`))
+			}))
+			defer server.Close()
+
+			ctx := t.Context()
+			if testCase.attempt != nil {
+				ctx = withAttempt(ctx, testCase.attempt)
+			}
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			client := &http.Client{Transport: &sdkTransport{base: http.DefaultTransport}}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if string(body) != `[]` {
+				t.Errorf("expected body %q, got %q", `[]`, body)
+			}
+
+			if testCase.attempt == nil {
+				return
+			}
+			if !testCase.attempt.sent {
+				t.Error("expected the attempt to be sent")
+			}
+			if testCase.attempt.resp != resp {
+				t.Error("expected the attempt to record the response")
+			}
+			if string(testCase.attempt.body) != `[]` {
+				t.Errorf("expected the attempt to record body %q, got %q", `[]`, testCase.attempt.body)
+			}
+		})
+	}
+}
+
+func TestAPIClientHeaders(t *testing.T) {
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client := mustAPIClient("test-key", server.URL, apiClientOptions{
+		ExtraHeaders: map[string]string{"X-Extra": "extra"},
+	})
+	if _, err := client.GetHostingEnvironments(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if header == nil {
+		t.Fatal("expected a request, got none")
+	}
+	if got := header.Get("Authorization"); got != "Bearer test-key" {
+		t.Errorf("expected the API key to be sent, got Authorization %q", got)
+	}
+	if got := header.Get("X-Extra"); got != "extra" {
+		t.Errorf("expected extra header %q, got %q", "extra", got)
+	}
+}
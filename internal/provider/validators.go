@@ -0,0 +1,92 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	traceforce "github.com/traceforce/traceforce-go-sdk"
+)
+
+// stringValues converts SDK enum constants to the plain strings expected by
+// the framework string validators.
+func stringValues[T ~string](values ...T) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, string(v))
+	}
+	return result
+}
+
+// nativeIDFormat describes the expected native_id of a project for a cloud
+// provider.
+type nativeIDFormat struct {
+	pattern     *regexp.Regexp
+	description string
+}
+
+// nativeIDFormats maps each cloud provider to its native_id format.
+var nativeIDFormats = map[traceforce.CloudProvider]nativeIDFormat{
+	traceforce.CloudProviderAWS: {
+		pattern:     regexp.MustCompile(`^\d{12}$`),
+		description: "a 12-digit AWS account ID",
+	},
+	traceforce.CloudProviderGCP: {
+		pattern:     regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]$`),
+		description: "a GCP project ID of 6 to 30 lowercase letters, digits or hyphens that starts with a letter and does not end with a hyphen",
+	},
+	traceforce.CloudProviderAzure: {
+		pattern:     regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`),
+		description: "an Azure subscription ID in UUID format",
+	},
+}
+
+var _ resource.ConfigValidator = projectNativeIDValidator{}
+
+// projectNativeIDValidator checks that the native_id of a project matches the
+// format of its cloud_provider. Unknown values are skipped so that the
+// attributes can be wired from other resources or modules.
+type projectNativeIDValidator struct{}
+
+func (v projectNativeIDValidator) Description(_ context.Context) string {
+	return "native_id must match the format of the configured cloud_provider"
+}
+
+func (v projectNativeIDValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v projectNativeIDValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cloudProvider, nativeID types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cloud_provider"), &cloudProvider)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("native_id"), &nativeID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if cloudProvider.IsNull() || cloudProvider.IsUnknown() || nativeID.IsNull() || nativeID.IsUnknown() {
+		return
+	}
+
+	// Unsupported cloud providers are reported by the cloud_provider validator.
+	format, ok := nativeIDFormats[traceforce.CloudProvider(cloudProvider.ValueString())]
+	if !ok {
+		return
+	}
+
+	if !format.pattern.MatchString(nativeID.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("native_id"),
+			"Invalid native ID",
+			fmt.Sprintf("The native_id of a project with cloud_provider %q must be %s, got: %q.",
+				cloudProvider.ValueString(), format.description, nativeID.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEnumAttributeValidators(t *testing.T) {
+	testCases := map[string]struct {
+		resource  resource.Resource
+		attribute string
+		valid     []string
+		invalid   []string
+	}{
+		"project-type": {
+			resource:  NewProjectResource(),
+			attribute: "type",
+			valid:     []string{"customer_managed", "traceforce_managed"},
+			invalid:   []string{"Customer Managed", "customer-managed"},
+		},
+		"project-cloud-provider": {
+			resource:  NewProjectResource(),
+			attribute: "cloud_provider",
+			valid:     []string{"aws", "gcp", "azure"},
+			invalid:   []string{"GCP", "google"},
+		},
+		"project-wait-for-status": {
+			resource:  NewProjectResource(),
+			attribute: "wait_for_status",
+			valid:     []string{"connected"},
+			invalid:   []string{"Connected", "ready", "pending", "disconnected"},
+		},
+		"post-connection-delete-behavior": {
+			resource:  NewPostConnectionResource(),
+			attribute: "delete_behavior",
+			valid:     []string{"abandon"},
+			invalid:   []string{"Abandon", "disconnect"},
+		},
+		"datalake-type": {
+			resource:  NewDatalakeResource(),
+			attribute: "type",
+			valid:     []string{"bigquery"},
+			invalid:   []string{"Bigquery", "snowflake"},
+		},
+		"source-app-type": {
+			resource:  NewSourceAppResource(),
+			attribute: "type",
+			valid:     []string{"salesforce"},
+			invalid:   []string{"Salesforce", "hubspot"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+
+			schemaResp := &resource.SchemaResponse{}
+			testCase.resource.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			attribute, ok := schemaResp.Schema.Attributes[testCase.attribute].(schema.StringAttribute)
+			if !ok {
+				t.Fatalf("expected %q to be a string attribute", testCase.attribute)
+			}
+
+			validate := func(value string) bool {
+				resp := &validator.StringResponse{}
+				for _, v := range attribute.Validators {
+					v.ValidateString(ctx, validator.StringRequest{
+						Path:        path.Root(testCase.attribute),
+						ConfigValue: types.StringValue(value),
+					}, resp)
+				}
+				return !resp.Diagnostics.HasError()
+			}
+
+			for _, value := range testCase.valid {
+				if !validate(value) {
+					t.Errorf("expected %q to be valid", value)
+				}
+			}
+			for _, value := range testCase.invalid {
+				if validate(value) {
+					t.Errorf("expected %q to be invalid", value)
+				}
+			}
+		})
+	}
+}
+
+func TestProjectNativeIDValidator(t *testing.T) {
+	testCases := map[string]struct {
+		cloudProvider types.String
+		nativeID      types.String
+		expectError   bool
+	}{
+		"aws-valid":              {cloudProvider: types.StringValue("aws"), nativeID: types.StringValue("123456789012")},
+		"aws-too-short":          {cloudProvider: types.StringValue("aws"), nativeID: types.StringValue("9876543210"), expectError: true},
+		"aws-not-numeric":        {cloudProvider: types.StringValue("aws"), nativeID: types.StringValue("my-aws-account"), expectError: true},
+		"gcp-valid":              {cloudProvider: types.StringValue("gcp"), nativeID: types.StringValue("my-gcp-project")},
+		"gcp-uppercase":          {cloudProvider: types.StringValue("gcp"), nativeID: types.StringValue("My-GCP-Project"), expectError: true},
+		"gcp-trailing-hyphen":    {cloudProvider: types.StringValue("gcp"), nativeID: types.StringValue("my-gcp-project-"), expectError: true},
+		"gcp-too-short":          {cloudProvider: types.StringValue("gcp"), nativeID: types.StringValue("gcp"), expectError: true},
+		"azure-valid":            {cloudProvider: types.StringValue("azure"), nativeID: types.StringValue("0b1f6471-1bf0-4dda-aec3-cb9272f09590")},
+		"azure-not-uuid":         {cloudProvider: types.StringValue("azure"), nativeID: types.StringValue("123456789012"), expectError: true},
+		"unknown-native-id":      {cloudProvider: types.StringValue("aws"), nativeID: types.StringUnknown()},
+		"unknown-cloud-provider": {cloudProvider: types.StringUnknown(), nativeID: types.StringValue("anything")},
+		"unsupported-provider":   {cloudProvider: types.StringValue("oracle"), nativeID: types.StringValue("anything")},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+
+			schemaResp := &resource.SchemaResponse{}
+			NewProjectResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			// Build the configuration through a state, which supports setting
+			// a whole model.
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			diags := state.Set(ctx, &projectResourceModel{
+				Name:          types.StringValue("test"),
+				Type:          types.StringValue("customer_managed"),
+				CloudProvider: testCase.cloudProvider,
+				NativeId:      testCase.nativeID,
+				Timeouts:      nullTimeouts("create", "update"),
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			projectNativeIDValidator{}.ValidateResource(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw},
+			}, resp)
+
+			if testCase.expectError != resp.Diagnostics.HasError() {
+				t.Fatalf("expected error: %t, got diagnostics: %v", testCase.expectError, resp.Diagnostics)
+			}
+			if testCase.expectError {
+				withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+				if !ok || !withPath.Path().Equal(path.Root("native_id")) {
+					t.Errorf("expected error on native_id, got: %v", resp.Diagnostics)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// statusPollInterval is how often the API is polled while waiting for an
+// object to reach a status.
+var statusPollInterval = 5 * time.Second
+
+// unexpectedStatusError is returned when an object reaches a status from which
+// the awaited status can no longer be reached.
+type unexpectedStatusError struct {
+	Status string
+	Target string
+}
+
+func (e *unexpectedStatusError) Error() string {
+	return fmt.Sprintf("reached status %q while waiting for status %q", e.Status, e.Target)
+}
+
+// waitForStatus polls refresh until the object it returns reaches the target
+// status. It fails with an *unexpectedStatusError as soon as the object
+// reaches one of the failed statuses, and with the context error when ctx is
+// done first. The most recently fetched object is always returned, so callers
+// can record it in state even when waiting fails.
+func waitForStatus[T any](ctx context.Context, refresh func() (T, string, error), target string, failed []string) (T, error) {
+	ticker := time.NewTicker(statusPollInterval)
+	defer ticker.Stop()
+
+	var last T
+	for {
+		current, status, err := refresh()
+		if err != nil {
+			return last, err
+		}
+		last = current
+
+		if status == target {
+			return last, nil
+		}
+
+		if slices.Contains(failed, status) {
+			return last, &unexpectedStatusError{Status: status, Target: target}
+		}
+
+		select {
+		case <-ctx.Done():
+			return last, fmt.Errorf("stopped waiting for status %q, last status was %q: %w", target, status, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
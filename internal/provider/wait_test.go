@@ -0,0 +1,79 @@
+// Copyright (c) Traceforce, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaitForStatus(t *testing.T) {
+	setStatusPollInterval(t, time.Millisecond)
+
+	// statusSequence returns a refresh function that reports the given
+	// statuses in order, repeating the last one.
+	statusSequence := func(statuses ...string) func() (int, string, error) {
+		calls := 0
+		return func() (int, string, error) {
+			status := statuses[min(calls, len(statuses)-1)]
+			calls++
+			return calls, status, nil
+		}
+	}
+
+	t.Run("reaches-target", func(t *testing.T) {
+		calls, err := waitForStatus(t.Context(), statusSequence("pending", "pending", "connected"), "connected", []string{"disconnected"})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if calls != 3 {
+			t.Errorf("expected 3 polls, got %d", calls)
+		}
+	})
+
+	t.Run("fails-on-failed-status", func(t *testing.T) {
+		calls, err := waitForStatus(t.Context(), statusSequence("pending", "disconnected"), "connected", []string{"disconnected"})
+
+		var statusErr *unexpectedStatusError
+		if !errors.As(err, &statusErr) {
+			t.Fatalf("expected unexpected status error, got: %v", err)
+		}
+		if statusErr.Status != "disconnected" {
+			t.Errorf("expected status %q, got %q", "disconnected", statusErr.Status)
+		}
+		if calls != 2 {
+			t.Errorf("expected the last polled object to be returned, got poll %d", calls)
+		}
+	})
+
+	t.Run("stops-when-context-done", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+		defer cancel()
+
+		_, err := waitForStatus(ctx, statusSequence("pending"), "connected", nil)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected deadline exceeded error, got: %v", err)
+		}
+	})
+
+	t.Run("returns-refresh-errors", func(t *testing.T) {
+		refreshErr := errors.New("refresh failed")
+		_, err := waitForStatus(t.Context(), func() (int, string, error) { return 0, "", refreshErr }, "connected", nil)
+		if !errors.Is(err, refreshErr) {
+			t.Fatalf("expected refresh error, got: %v", err)
+		}
+	})
+}
+
+// setStatusPollInterval overrides the status poll interval for the duration
+// of the test.
+func setStatusPollInterval(t *testing.T, interval time.Duration) {
+	t.Helper()
+
+	previous := statusPollInterval
+	statusPollInterval = interval
+	t.Cleanup(func() { statusPollInterval = previous })
+}
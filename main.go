@@ -6,7 +6,10 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
+	"os/signal"
 
 	"terraform-provider-traceforce/internal/provider"
 
@@ -23,6 +26,11 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		export(os.Args[2:])
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -39,3 +47,29 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// export runs the export subcommand, which writes Terraform configuration for
+// an existing Traceforce account.
+func export(args []string) {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	dir := flags.String("dir", ".", "directory to write the generated .tf files to")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s export [-dir DIR]\n\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Writes resource and import blocks for every project, datalake, source app and")
+		fmt.Fprintln(flags.Output(), "source app datalake link of the account selected by TRACEFORCE_API_KEY and")
+		fmt.Fprintln(flags.Output(), "TRACEFORCE_ENDPOINT. Requests are retried according to TRACEFORCE_MAX_RETRIES,")
+		fmt.Fprintln(flags.Output(), "TRACEFORCE_RETRY_MIN_BACKOFF and TRACEFORCE_RETRY_MAX_BACKOFF. Existing files")
+		fmt.Fprintln(flags.Output(), "are never overwritten: if any of the files exists, nothing is written.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	// Interrupting the export aborts the requests in flight.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := provider.Export(ctx, *dir); err != nil {
+		log.Fatal(err.Error())
+	}
+}
@@ -0,0 +1,96 @@
+package traceforce
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultBaseURL = "https://api.traceforce.co/api/v1"
+)
+
+type Client struct {
+	httpClient   *http.Client
+	baseURL      string
+	apiKey       string
+	extraHeaders map[string]string
+	ctx          context.Context
+}
+
+type ClientOptions struct {
+	// ExtraHeaders allows adding additional headers to all API requests.
+	ExtraHeaders map[string]string `json:"extra_headers,omitempty"`
+
+	// HTTPClient is the HTTP client used to send API requests. When nil, a
+	// client with a 30 second timeout is used.
+	HTTPClient *http.Client `json:"-"`
+}
+
+// NewClient creates a new Traceforce client.
+// key is the Traceforce API key.
+// url is the Traceforce URL.
+// options is the Traceforce client options.
+func NewClient(key, url string, options *ClientOptions) (*Client, error) {
+	if url == "" {
+		url = defaultBaseURL
+	}
+
+	if options == nil {
+		options = &ClientOptions{}
+	}
+
+	extraHeaders := make(map[string]string)
+
+	// Copy user-provided headers
+	for k, v := range options.ExtraHeaders {
+		extraHeaders[k] = v
+	}
+
+	httpClient := options.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: 30 * time.Second,
+		}
+	}
+
+	return &Client{
+		httpClient:   httpClient,
+		baseURL:      url,
+		apiKey:       key,
+		extraHeaders: extraHeaders,
+	}, nil
+}
+
+// WithContext returns a shallow copy of the client whose requests are sent
+// with ctx, so that cancelling ctx or reaching its deadline aborts them.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	if ctx == nil {
+		panic("nil context")
+	}
+	c2 := *c
+	c2.ctx = ctx
+	return &c2
+}
+
+// context returns the context requests are sent with.
+func (c *Client) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
+// buildHeaders creates a headers map with authorization and any extra headers
+func (c *Client) buildHeaders() map[string]string {
+	headers := map[string]string{
+		"Authorization": "Bearer " + c.apiKey,
+	}
+
+	// Add extra headers
+	for k, v := range c.extraHeaders {
+		headers[k] = v
+	}
+
+	return headers
+}
@@ -0,0 +1,144 @@
+package traceforce
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNewClientWithExtraHeaders(t *testing.T) {
+	// Test client creation without extra headers
+	client1, err := NewClient("test-key", "https://example.com", nil)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	
+	if client1.apiKey != "test-key" {
+		t.Errorf("Expected apiKey 'test-key', got '%s'", client1.apiKey)
+	}
+	
+	if client1.baseURL != "https://example.com" {
+		t.Errorf("Expected baseURL 'https://example.com', got '%s'", client1.baseURL)
+	}
+	
+	if len(client1.extraHeaders) != 0 {
+		t.Errorf("Expected no extra headers, got %d", len(client1.extraHeaders))
+	}
+	
+	// Test client creation with extra headers
+	options := &ClientOptions{
+		ExtraHeaders: map[string]string{
+			"x-vercel-protection-bypass": "test-token",
+			"x-custom-header":            "test-value",
+		},
+	}
+	
+	client2, err := NewClient("test-key", "https://example.com", options)
+	if err != nil {
+		t.Fatalf("Failed to create client with extra headers: %v", err)
+	}
+	
+	if len(client2.extraHeaders) != 2 {
+		t.Errorf("Expected 2 extra headers, got %d", len(client2.extraHeaders))
+	}
+	
+	if client2.extraHeaders["x-vercel-protection-bypass"] != "test-token" {
+		t.Errorf("Expected bypass token 'test-token', got '%s'", client2.extraHeaders["x-vercel-protection-bypass"])
+	}
+	
+	if client2.extraHeaders["x-custom-header"] != "test-value" {
+		t.Errorf("Expected custom header 'test-value', got '%s'", client2.extraHeaders["x-custom-header"])
+	}
+}
+
+func TestBuildHeaders(t *testing.T) {
+	options := &ClientOptions{
+		ExtraHeaders: map[string]string{
+			"x-vercel-protection-bypass": "test-token",
+			"x-custom-header":            "test-value",
+		},
+	}
+	
+	client, err := NewClient("test-api-key", "https://example.com", options)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	
+	headers := client.buildHeaders()
+	
+	// Check authorization header
+	if headers["Authorization"] != "Bearer test-api-key" {
+		t.Errorf("Expected Authorization 'Bearer test-api-key', got '%s'", headers["Authorization"])
+	}
+	
+	// Check extra headers are included
+	if headers["x-vercel-protection-bypass"] != "test-token" {
+		t.Errorf("Expected bypass token 'test-token', got '%s'", headers["x-vercel-protection-bypass"])
+	}
+	
+	if headers["x-custom-header"] != "test-value" {
+		t.Errorf("Expected custom header 'test-value', got '%s'", headers["x-custom-header"])
+	}
+	
+	// Should have 3 headers total
+	if len(headers) != 3 {
+		t.Errorf("Expected 3 headers, got %d", len(headers))
+	}
+}
+func TestWithContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client, err := NewClient("test-key", server.URL, nil)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = client.WithContext(ctx).GetHostingEnvironments()
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+
+	if client.context() != context.Background() {
+		t.Error("Expected WithContext not to change the original client")
+	}
+}
+
+type recordingTransport struct {
+	requests int
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestNewClientWithHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	transport := &recordingTransport{}
+	client, err := NewClient("test-key", server.URL, &ClientOptions{
+		HTTPClient: &http.Client{Transport: transport},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if _, err := client.GetHostingEnvironments(); err != nil {
+		t.Fatalf("Failed to get hosting environments: %v", err)
+	}
+	if transport.requests != 1 {
+		t.Errorf("Expected 1 request through the custom transport, got %d", transport.requests)
+	}
+}